@@ -0,0 +1,23 @@
+package wotsp
+
+import (
+	"github.com/lentus/wotsp/primitives"
+)
+
+// Address is a structured 32-byte hash address (ADRS) as described in section
+// 2.5 of RFC 8391. An Address converts to and from the [32]byte used by
+// Opts.Address with a plain type conversion. See primitives.Address for the
+// layout of its fields.
+type Address = primitives.Address
+
+// AddressType identifies the type of a hash address.
+type AddressType = primitives.AddressType
+
+// Address types, see the corresponding constants in package primitives.
+const (
+	AddressTypeOTS       = primitives.AddressTypeOTS
+	AddressTypeLTree     = primitives.AddressTypeLTree
+	AddressTypeHashTree  = primitives.AddressTypeHashTree
+	AddressTypeFORSTree  = primitives.AddressTypeFORSTree
+	AddressTypeFORSRoots = primitives.AddressTypeFORSRoots
+)
@@ -0,0 +1,30 @@
+package wotsp
+
+import (
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+// TestAddressOpts verifies that an Address can be used as Opts.Address.
+func TestAddressOpts(t *testing.T) {
+	var a Address
+	a.SetOTS(9)
+
+	var opts Opts
+	opts.Address = [32]byte(a)
+
+	sig := Sign(testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	if Verify(testdata.PubKey, sig, testdata.Message, testdata.PubSeed, opts) {
+		t.Error("Address not used")
+	}
+
+	pubKey := GenPublicKey(testdata.Seed, testdata.PubSeed, opts)
+	if !Verify(pubKey, sig, testdata.Message, testdata.PubSeed, opts) {
+		t.Error("Valid signature rejected")
+	}
+
+	if Address(opts.Address).OTS() != 9 {
+		t.Error("Address does not round trip")
+	}
+}
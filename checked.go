@@ -0,0 +1,104 @@
+package wotsp
+
+import (
+	"fmt"
+)
+
+// The functions in this file are variants of the W-OTS+ operations in wots.go
+// that validate their inputs before use. Where GenPublicKey, Sign,
+// PublicKeyFromSig and Verify panic on an invalid Opts or input of the wrong
+// length, the functions below return an error wrapping one of the Err values
+// from errors.go instead. They should be used whenever the inputs are not
+// trusted, such as signatures received over the network.
+
+// GenPublicKeyChecked is like GenPublicKey, but returns an error instead of
+// panicking when opts or the lengths of seed and pubSeed are invalid.
+func GenPublicKeyChecked(seed, pubSeed []byte, opts Opts) ([]byte, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	s := opts.sizes()
+	if err := checkLength(ErrSeedLength, seed, s.seed); err != nil {
+		return nil, err
+	}
+	if err := checkLength(ErrPubSeedLength, pubSeed, s.pubSeed); err != nil {
+		return nil, err
+	}
+
+	return GenPublicKey(seed, pubSeed, opts), nil
+}
+
+// SignChecked is like Sign, but returns an error instead of panicking when
+// opts or the lengths of msg, seed and pubSeed are invalid, or when a fault is
+// detected by opts.VerifyAfterSign.
+func SignChecked(msg, seed, pubSeed []byte, opts Opts) ([]byte, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	s := opts.sizes()
+	if err := checkLength(ErrMessageLength, msg, opts.n()); err != nil {
+		return nil, err
+	}
+	if err := checkLength(ErrSeedLength, seed, s.seed); err != nil {
+		return nil, err
+	}
+	if err := checkLength(ErrPubSeedLength, pubSeed, s.pubSeed); err != nil {
+		return nil, err
+	}
+
+	return sign(msg, seed, pubSeed, opts)
+}
+
+// PublicKeyFromSigChecked is like PublicKeyFromSig, but returns an error
+// instead of panicking when opts or the lengths of sig, msg and pubSeed are
+// invalid.
+func PublicKeyFromSigChecked(sig, msg, pubSeed []byte, opts Opts) ([]byte, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	s := opts.sizes()
+	if err := checkLength(ErrSignatureLength, sig, s.signature); err != nil {
+		return nil, err
+	}
+	if err := checkLength(ErrMessageLength, msg, opts.n()); err != nil {
+		return nil, err
+	}
+	if err := checkLength(ErrPubSeedLength, pubSeed, s.pubSeed); err != nil {
+		return nil, err
+	}
+
+	return PublicKeyFromSig(sig, msg, pubSeed, opts), nil
+}
+
+// VerifyChecked is like Verify, but returns an error instead of panicking when
+// opts or the lengths of pk, sig, msg and pubSeed are invalid. A signature that
+// is well-formed but does not match pk is reported as (false, nil).
+func VerifyChecked(pk, sig, msg, pubSeed []byte, opts Opts) (bool, error) {
+	if err := opts.validate(); err != nil {
+		return false, err
+	}
+
+	if err := checkLength(ErrPublicKeyLength, pk, opts.sizes().publicKey); err != nil {
+		return false, err
+	}
+
+	pubKeyFromSig, err := PublicKeyFromSigChecked(sig, msg, pubSeed, opts)
+	if err != nil {
+		return false, err
+	}
+
+	return verifyPublicKey(pk, pubKeyFromSig), nil
+}
+
+// checkLength returns an error wrapping errLength if b is not exactly n bytes
+// long.
+func checkLength(errLength error, b []byte, n int) error {
+	if len(b) != n {
+		return fmt.Errorf("%w: got %d bytes, expected %d", errLength, len(b), n)
+	}
+
+	return nil
+}
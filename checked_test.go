@@ -0,0 +1,104 @@
+package wotsp
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+// TestCheckedValid verifies that the checked variants produce the same results
+// as the reference implementation for valid inputs.
+func TestCheckedValid(t *testing.T) {
+	var opts Opts
+	opts.Mode = W16
+
+	pubKey, err := GenPublicKeyChecked(testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+	if !bytes.Equal(pubKey, testdata.PubKey) {
+		t.Error("Wrong key")
+	}
+
+	signature, err := SignChecked(testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+	if !bytes.Equal(signature, testdata.Signature) {
+		t.Error("Wrong signature")
+	}
+
+	pubKey, err = PublicKeyFromSigChecked(testdata.Signature, testdata.Message, testdata.PubSeed, opts)
+	noerr(t, err)
+	if !bytes.Equal(pubKey, testdata.PubKey) {
+		t.Error("Wrong public key")
+	}
+
+	ok, err := VerifyChecked(testdata.PubKey, testdata.Signature, testdata.Message, testdata.PubSeed, opts)
+	noerr(t, err)
+	if !ok {
+		t.Error("Valid signature rejected")
+	}
+}
+
+// TestCheckedInvalid verifies that the checked variants return the expected
+// errors, instead of panicking, on invalid inputs.
+func TestCheckedInvalid(t *testing.T) {
+	var opts Opts
+	short := make([]byte, 31)
+
+	tests := []struct {
+		name string
+		err  error
+		run  func(opts Opts) error
+	}{
+		{"InvalidMode", ErrInvalidMode, func(opts Opts) error {
+			opts.Mode = Mode(42)
+			_, err := GenPublicKeyChecked(testdata.Seed, testdata.PubSeed, opts)
+			return err
+		}},
+		{"UnsupportedHash", ErrUnsupportedHash, func(opts Opts) error {
+			opts.Hash = crypto.MD5
+			_, err := SignChecked(testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+			return err
+		}},
+		{"UnavailableHash", ErrUnsupportedHash, func(opts Opts) error {
+			opts.Hash = crypto.BLAKE2s_256
+			_, err := SignChecked(testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+			return err
+		}},
+		{"SeedLength", ErrSeedLength, func(opts Opts) error {
+			_, err := GenPublicKeyChecked(short, testdata.PubSeed, opts)
+			return err
+		}},
+		{"PubSeedLength", ErrPubSeedLength, func(opts Opts) error {
+			_, err := SignChecked(testdata.Message, testdata.Seed, short, opts)
+			return err
+		}},
+		{"MessageLength", ErrMessageLength, func(opts Opts) error {
+			_, err := PublicKeyFromSigChecked(testdata.Signature, short, testdata.PubSeed, opts)
+			return err
+		}},
+		{"SignatureLength", ErrSignatureLength, func(opts Opts) error {
+			_, err := PublicKeyFromSigChecked(testdata.Signature[:N], testdata.Message, testdata.PubSeed, opts)
+			return err
+		}},
+		{"SignatureLengthMode", ErrSignatureLength, func(opts Opts) error {
+			opts.Mode = W4
+			_, err := VerifyChecked(make([]byte, W4PublicKeyBytes), testdata.Signature, testdata.Message, testdata.PubSeed, opts)
+			return err
+		}},
+		{"PublicKeyLength", ErrPublicKeyLength, func(opts Opts) error {
+			_, err := VerifyChecked(short, testdata.Signature, testdata.Message, testdata.PubSeed, opts)
+			return err
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.run(opts)
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected error [%v], got [%v]", test.err, err)
+			}
+		})
+	}
+}
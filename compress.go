@@ -0,0 +1,83 @@
+package wotsp
+
+import (
+	"crypto/subtle"
+)
+
+// Public keys can be compressed to a single n-byte node using the L-tree of
+// RFC 8391, as is done for the leaves of an XMSS tree. The L-tree address is
+// derived from Opts.Address: it keeps the layer and tree address, and uses the
+// OTS address as the L-tree address. A compressed public key is sufficient to
+// verify signatures, since the full public key can be recovered from any valid
+// signature using PublicKeyFromSig.
+
+// CompressPublicKey compresses the public key pk to a single n-byte node. An
+// error is returned if opts or the input lengths are invalid.
+func CompressPublicKey(pk, pubSeed []byte, opts Opts) ([]byte, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	s := opts.sizes()
+	if err := checkLength(ErrPublicKeyLength, pk, s.publicKey); err != nil {
+		return nil, err
+	}
+	if err := checkLength(ErrPubSeedLength, pubSeed, s.pubSeed); err != nil {
+		return nil, err
+	}
+
+	return compressPublicKey(pk, pubSeed, opts), nil
+}
+
+// VerifyCompressed checks whether sig is a signature of msg for the public key
+// whose compressed form is pkHash. The public key is recovered from the
+// signature, compressed, and compared to pkHash. Malformed inputs are reported
+// as an invalid signature.
+func VerifyCompressed(pkHash, sig, msg, pubSeed []byte, opts Opts) bool {
+	pubKeyFromSig, err := PublicKeyFromSigChecked(sig, msg, pubSeed, opts)
+	if err != nil {
+		return false
+	}
+
+	return verifyCompressed(pkHash, pubKeyFromSig, pubSeed, opts)
+}
+
+// VerifyMessageCompressed is like VerifyCompressed, for signatures created by
+// SignMessage.
+func VerifyMessageCompressed(pkHash, sig, msg, pubSeed []byte, opts Opts) bool {
+	pubKeyFromSig, err := PublicKeyFromMessageSig(sig, msg, pubSeed, opts)
+	if err != nil {
+		return false
+	}
+
+	return verifyCompressed(pkHash, pubKeyFromSig, pubSeed, opts)
+}
+
+// Compress returns the compressed form of the public key.
+func (pub *PublicKey) Compress() []byte {
+	return compressPublicKey(pub.pk, pub.pubSeed, pub.opts)
+}
+
+// verifyCompressed compresses a public key recovered from a signature and
+// compares it to pkHash.
+func verifyCompressed(pkHash, pubKeyFromSig, pubSeed []byte, opts Opts) bool {
+	compressed := compressPublicKey(pubKeyFromSig, pubSeed, opts)
+
+	// use subtle.ConstantTimeCompare instead of bytes.Equal to avoid timing
+	// attacks.
+	return subtle.ConstantTimeCompare(pkHash, compressed) == 1
+}
+
+// compressPublicKey computes the L-tree root of pk. The inputs must be valid.
+func compressPublicKey(pk, pubSeed []byte, opts Opts) []byte {
+	adrs := Address(opts.Address)
+	ots := adrs.OTS()
+	adrs.SetType(AddressTypeLTree)
+	adrs.SetLTree(ots)
+
+	h := opts.newHasher(nil, pubSeed)
+	root := h.LTree(pk, &adrs)
+	opts.observe(OperationCompress, h)
+
+	return root
+}
@@ -0,0 +1,63 @@
+package wotsp
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+// TestVerifyCompressed verifies signatures against compressed public keys for
+// both tweakable hash functions.
+func TestVerifyCompressed(t *testing.T) {
+	for _, tweak := range []Tweak{Robust, Simple} {
+		var opts Opts
+		opts.Tweak = tweak
+
+		pubKey := GenPublicKey(testdata.Seed, testdata.PubSeed, opts)
+		sig := Sign(testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+
+		pkHash, err := CompressPublicKey(pubKey, testdata.PubSeed, opts)
+		noerr(t, err)
+		if len(pkHash) != opts.CompressedPublicKeyBytes() {
+			t.Errorf("%s: wrong compressed key length %d", tweak, len(pkHash))
+		}
+
+		if !VerifyCompressed(pkHash, sig, testdata.Message, testdata.PubSeed, opts) {
+			t.Errorf("%s: valid signature rejected", tweak)
+		}
+
+		msg := append([]byte{}, testdata.Message...)
+		msg[0] ^= 1
+		if VerifyCompressed(pkHash, sig, msg, testdata.PubSeed, opts) {
+			t.Errorf("%s: signature accepted for a different message", tweak)
+		}
+
+		// The compressed key depends on the address
+		other := opts
+		other.Address[19] = 1
+		otherHash, err := CompressPublicKey(pubKey, testdata.PubSeed, other)
+		noerr(t, err)
+		if bytes.Equal(pkHash, otherHash) {
+			t.Errorf("%s: compressed key does not depend on the address", tweak)
+		}
+	}
+}
+
+// TestVerifyMessageCompressed verifies message signatures against a
+// compressed public key obtained from the typed API.
+func TestVerifyMessageCompressed(t *testing.T) {
+	var opts Opts
+	opts.Mode = W4
+
+	priv, pub, err := GenerateKey(nil, opts)
+	noerr(t, err)
+
+	msg := []byte("transfer 10 coins")
+	sig, err := priv.SignMessage(nil, msg)
+	noerr(t, err)
+
+	if !VerifyMessageCompressed(pub.Compress(), sig, msg, pub.PubSeed(), opts) {
+		t.Error("Valid signature rejected")
+	}
+}
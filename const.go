@@ -1,6 +1,10 @@
 package wotsp
 
 // constants for W-OTS+ signatures for both W4, W16 and W256 modes.
+//
+// Deprecated: these sizes only hold for the default hash output length. Use
+// the size methods of Opts, such as Opts.PublicKeyBytes, Opts.SignatureBytes
+// and Opts.SeedBytes, instead.
 const (
 	W4PublicKeyBytes = 4256        // size of public key
 	W4SecretKeyBytes = W4SeedBytes // size of the secret key, which is the seed
@@ -23,3 +27,10 @@ const (
 	W256PubSeedBytes   = 32
 	W256AddressBytes   = 32
 )
+
+// maxN is the largest supported security parameter n, which is encoded in a
+// single byte.
+const maxN = 255
+
+// MaxContextBytes is the maximum length of Opts.Context.
+const MaxContextBytes = 255
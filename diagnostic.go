@@ -0,0 +1,71 @@
+package wotsp
+
+import (
+	"bytes"
+)
+
+// VerifyDetails holds the intermediate results of a signature verification by
+// VerifyDetailed.
+type VerifyDetails struct {
+	// Valid reports whether the signature is valid, which is the case if and
+	// only if MismatchedChains is empty.
+	Valid bool
+
+	// PublicKey is the public key recomputed from the signature.
+	PublicKey []byte
+
+	// Digits holds the base-w digits of the message, which are the start
+	// positions of the first L1 signature chains.
+	Digits []uint8
+
+	// ChecksumDigits holds the base-w digits of the checksum, which are the
+	// start positions of the remaining L2 signature chains.
+	ChecksumDigits []uint8
+
+	// MismatchedChains holds the indices of the chains whose recomputed end
+	// value differs from the corresponding value in the given public key, in
+	// ascending order.
+	MismatchedChains []int
+}
+
+// VerifyDetailed verifies a signature like VerifyChecked, but reports the
+// intermediate results of the verification and the chains that do not match
+// the public key. It is meant for debugging interoperability with other
+// implementations.
+//
+// VerifyDetailed is NOT constant time and reveals more than whether the
+// signature is valid; it must not be used to make production decisions. Use
+// Verify or VerifyChecked instead.
+func VerifyDetailed(pk, sig, msg, pubSeed []byte, opts Opts) (*VerifyDetails, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := checkLength(ErrPublicKeyLength, pk, opts.sizes().publicKey); err != nil {
+		return nil, err
+	}
+
+	pubKeyFromSig, err := PublicKeyFromSigChecked(sig, msg, pubSeed, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	params := opts.params()
+	n := params.N
+	digits := params.Digits(msg)
+
+	details := &VerifyDetails{
+		PublicKey:      pubKeyFromSig,
+		Digits:         digits[:params.L1],
+		ChecksumDigits: digits[params.L1:],
+	}
+
+	for i := 0; i < params.L; i++ {
+		if !bytes.Equal(pk[i*n:(i+1)*n], pubKeyFromSig[i*n:(i+1)*n]) {
+			details.MismatchedChains = append(details.MismatchedChains, i)
+		}
+	}
+	details.Valid = len(details.MismatchedChains) == 0
+
+	return details, nil
+}
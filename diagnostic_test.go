@@ -0,0 +1,43 @@
+package wotsp
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+// TestVerifyDetailed verifies that VerifyDetailed reports exactly the chains
+// that were corrupted in a signature.
+func TestVerifyDetailed(t *testing.T) {
+	var opts Opts
+	opts.Mode = W16
+
+	details, err := VerifyDetailed(testdata.PubKey, testdata.Signature, testdata.Message, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	if !details.Valid || len(details.MismatchedChains) != 0 {
+		t.Error("Valid signature rejected")
+	}
+	if !bytes.Equal(details.PublicKey, testdata.PubKey) {
+		t.Error("Wrong public key")
+	}
+	if len(details.Digits) != 64 || len(details.ChecksumDigits) != 3 {
+		t.Errorf("wrong number of digits: %d + %d", len(details.Digits), len(details.ChecksumDigits))
+	}
+
+	sig := append([]byte{}, testdata.Signature...)
+	sig[3*N] ^= 1
+	sig[66*N+N-1] ^= 1
+
+	details, err = VerifyDetailed(testdata.PubKey, sig, testdata.Message, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	if details.Valid {
+		t.Error("Invalid signature accepted")
+	}
+	if !reflect.DeepEqual(details.MismatchedChains, []int{3, 66}) {
+		t.Errorf("wrong mismatched chains %v", details.MismatchedChains)
+	}
+}
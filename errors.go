@@ -0,0 +1,75 @@
+package wotsp
+
+import (
+	"errors"
+)
+
+// Errors returned by the checked variants of the W-OTS+ operations and by the
+// key and signature types. The returned errors wrap these values with
+// additional detail, so they should be compared using errors.Is.
+var (
+	// ErrInvalidMode is returned when Opts.Mode is not a known Mode.
+	ErrInvalidMode = errors.New("wotsp: invalid mode")
+
+	// ErrUnsupportedHash is returned when Opts.Hash is not supported by the
+	// implementation, or when it is not linked into the binary.
+	ErrUnsupportedHash = errors.New("wotsp: unsupported hash function")
+
+	// ErrInvalidN is returned when Opts.N is out of range, or does not match
+	// the digest size of Opts.Hash.
+	ErrInvalidN = errors.New("wotsp: invalid security parameter n")
+
+	// ErrInvalidTweak is returned when Opts.Tweak is not a known Tweak.
+	ErrInvalidTweak = errors.New("wotsp: invalid tweakable hash")
+
+	// ErrUnknownParamSet is returned when looking up a parameter set that is
+	// not known or not supported.
+	ErrUnknownParamSet = errors.New("wotsp: unknown parameter set")
+
+	// ErrInvalidKeyDerivation is returned when Opts.KeyDerivation is not a
+	// known KeyDerivation, or not the one required by Opts.N.
+	ErrInvalidKeyDerivation = errors.New("wotsp: invalid key derivation")
+
+	// ErrSeedLength is returned when the secret seed has the wrong length.
+	ErrSeedLength = errors.New("wotsp: invalid seed length")
+
+	// ErrPubSeedLength is returned when the public seed has the wrong length.
+	ErrPubSeedLength = errors.New("wotsp: invalid public seed length")
+
+	// ErrMessageLength is returned when the message has the wrong length.
+	ErrMessageLength = errors.New("wotsp: invalid message length")
+
+	// ErrSignatureLength is returned when the signature has the wrong length.
+	ErrSignatureLength = errors.New("wotsp: invalid signature length")
+
+	// ErrPublicKeyLength is returned when the public key has the wrong length.
+	ErrPublicKeyLength = errors.New("wotsp: invalid public key length")
+
+	// ErrUnsupportedPreHash is returned when the PreHash passed to a pre-hash
+	// signing operation is not known.
+	ErrUnsupportedPreHash = errors.New("wotsp: unsupported pre-hash function")
+
+	// ErrContextLength is returned when Opts.Context is longer than
+	// MaxContextBytes.
+	ErrContextLength = errors.New("wotsp: context string too long")
+
+	// ErrInvalidEncoding is returned when decoding a malformed binary encoding
+	// of a key or signature.
+	ErrInvalidEncoding = errors.New("wotsp: invalid encoding")
+
+	// ErrKeyUsed is returned when a OneTimeKey is used to sign a second time.
+	ErrKeyUsed = errors.New("wotsp: one-time key has already been used")
+
+	// ErrFaultDetected is returned when Opts.VerifyAfterSign is set and a
+	// freshly computed signature fails verification. The faulty signature is
+	// discarded.
+	ErrFaultDetected = errors.New("wotsp: fault detected, signature does not verify")
+
+	// ErrKeyMismatch is returned when signatures that are expected to belong to
+	// the same key do not.
+	ErrKeyMismatch = errors.New("wotsp: signatures belong to different keys")
+
+	// ErrOptsMismatch is returned when the Opts passed to an operation on a
+	// key do not match the Opts the key is bound to.
+	ErrOptsMismatch = errors.New("wotsp: options do not match the key")
+)
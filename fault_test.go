@@ -0,0 +1,82 @@
+package wotsp
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+// faultInjector is a Tracer that flips a bit in the first chain node it sees,
+// simulating a fault injected during signing.
+type faultInjector struct {
+	once sync.Once
+}
+
+func (f *faultInjector) PrivateKeyElement(int, []byte) {}
+
+func (f *faultInjector) PRF(Address, []byte) {}
+
+func (f *faultInjector) ChainNode(_ Address, node []byte) {
+	f.once.Do(func() { node[0] ^= 1 })
+}
+
+// TestVerifyAfterSign verifies that a faulty signature is released without
+// VerifyAfterSign, and withheld with it.
+func TestVerifyAfterSign(t *testing.T) {
+	var opts Opts
+	opts.Tracer = new(faultInjector)
+
+	sig, err := SignChecked(testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+	if Verify(testdata.PubKey, sig, testdata.Message, testdata.PubSeed, Opts{}) {
+		t.Fatal("Fault was not injected")
+	}
+
+	opts.Tracer = new(faultInjector)
+	opts.VerifyAfterSign = true
+
+	sig, err = SignChecked(testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	if !errors.Is(err, ErrFaultDetected) || sig != nil {
+		t.Errorf("expected error [%v], got [%v]", ErrFaultDetected, err)
+	}
+
+	_, err = SignMessage(nil, testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	if err != nil {
+		t.Errorf("fault injected more than once: %v", err)
+	}
+
+	opts.Tracer = new(faultInjector)
+	if sig := Sign(testdata.Message, testdata.Seed, testdata.PubSeed, opts); sig != nil {
+		t.Error("Faulty signature released by Sign")
+	}
+
+	// Without faults, signing succeeds as usual
+	opts.Tracer = nil
+	sig, err = SignChecked(testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+	if !Verify(testdata.PubKey, sig, testdata.Message, testdata.PubSeed, opts) {
+		t.Error("Valid signature rejected")
+	}
+}
+
+// TestVerifyAfterSignOneTimeKey verifies that a OneTimeKey is consumed when a
+// fault is detected.
+func TestVerifyAfterSignOneTimeKey(t *testing.T) {
+	var opts Opts
+	opts.VerifyAfterSign = true
+
+	priv, err := NewPrivateKey(testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	k := NewOneTimeKey(priv)
+	k.priv.opts.Tracer = new(faultInjector)
+
+	if _, err := k.Sign(nil, testdata.Message, nil); !errors.Is(err, ErrFaultDetected) {
+		t.Errorf("expected error [%v], got [%v]", ErrFaultDetected, err)
+	}
+	if !k.Used() {
+		t.Error("Key not consumed after fault")
+	}
+}
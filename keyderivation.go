@@ -0,0 +1,64 @@
+package wotsp
+
+import (
+	"fmt"
+
+	"github.com/lentus/wotsp/primitives"
+)
+
+// KeyDerivation selects how the elements of the private key are derived from
+// the secret seed. The default, which is used when no explicit KeyDerivation
+// is chosen, is SeedCounter.
+//
+// The derivation only affects the private key, and thus the public key and
+// signatures; verification is the same for both. A private key must always be
+// used with the KeyDerivation it was created with.
+type KeyDerivation int
+
+const (
+	// SeedCounter derives the i-th element of the private key as
+	// PRF(seed, toByte(i, 32)), as in RFC 8391. As the derivation depends on
+	// neither the public seed nor the address, it is susceptible to
+	// multi-key attacks. SeedCounter is the default.
+	SeedCounter KeyDerivation = iota
+
+	// AddressBound derives the i-th element of the private key as
+	// PRF_keygen(seed, pubSeed || ADRS) = H(toByte(4, n) || seed || pubSeed ||
+	// ADRS), where ADRS is Opts.Address with chain address i, as in NIST SP
+	// 800-208 and the SPHINCS+ reference code. Every element is bound to the
+	// public seed and its position.
+	AddressBound
+)
+
+// validate returns an error if the KeyDerivation is not valid.
+func (k KeyDerivation) validate() error {
+	switch k {
+	case SeedCounter, AddressBound:
+		return nil
+	default:
+		return fmt.Errorf("%w %s, must be either wotsp.SeedCounter or wotsp.AddressBound", ErrInvalidKeyDerivation, k)
+	}
+}
+
+// expandSeed expands the secret seed of h into the private key. The address
+// of the private key elements is taken from opts.
+func (k KeyDerivation) expandSeed(h *primitives.Hasher, opts Opts) []byte {
+	if k == AddressBound {
+		adrs := Address(opts.Address)
+		return h.ExpandSeedKeygen(&adrs)
+	}
+
+	return h.ExpandSeed()
+}
+
+// String implements fmt.Stringer.
+func (k KeyDerivation) String() string {
+	switch k {
+	case SeedCounter:
+		return "SeedCounter"
+	case AddressBound:
+		return "AddressBound"
+	default:
+		return fmt.Sprintf("<invalid key derivation %d>", k)
+	}
+}
@@ -0,0 +1,82 @@
+package wotsp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+// TestKeyDerivationAddressBound verifies the private key elements derived by
+// AddressBound against a direct computation of PRF_keygen, and that the
+// resulting keys depend on the address.
+func TestKeyDerivationAddressBound(t *testing.T) {
+	rec := new(recorder)
+
+	var opts Opts
+	opts.KeyDerivation = AddressBound
+	opts.Tracer = rec
+	opts.Address[15] = 3 // OTS address
+
+	pubKey := GenPublicKey(testdata.Seed, testdata.PubSeed, opts)
+	if len(rec.privKey) != 67 {
+		t.Fatalf("%d private key elements traced, expected 67", len(rec.privKey))
+	}
+
+	for _, i := range []int{0, 66} {
+		adrs := Address(opts.Address)
+		adrs.SetChain(uint32(i))
+
+		h := sha256.New()
+		h.Write(append(make([]byte, N-1), 4))
+		h.Write(testdata.Seed)
+		h.Write(testdata.PubSeed)
+		h.Write(adrs[:])
+
+		if !bytes.Equal(rec.privKey[i], h.Sum(nil)) {
+			t.Errorf("Wrong private key element %d", i)
+		}
+	}
+
+	opts.Tracer = nil
+	sig := Sign(testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	if !Verify(pubKey, sig, testdata.Message, testdata.PubSeed, opts) {
+		t.Error("Valid signature rejected")
+	}
+
+	if bytes.Equal(pubKey, testdata.PubKey) {
+		t.Error("AddressBound derives the same key as SeedCounter")
+	}
+
+	opts.Address[15] = 4
+	if bytes.Equal(pubKey, GenPublicKey(testdata.Seed, testdata.PubSeed, opts)) {
+		t.Error("AddressBound derives the same key for different addresses")
+	}
+}
+
+// TestKeyDerivationInvalid verifies that an invalid KeyDerivation is rejected,
+// and that the KeyDerivation is encoded with keys.
+func TestKeyDerivationInvalid(t *testing.T) {
+	var opts Opts
+	opts.KeyDerivation = KeyDerivation(7)
+
+	_, err := GenPublicKeyChecked(testdata.Seed, testdata.PubSeed, opts)
+	if !errors.Is(err, ErrInvalidKeyDerivation) {
+		t.Errorf("expected error [%v], got [%v]", ErrInvalidKeyDerivation, err)
+	}
+
+	opts.KeyDerivation = AddressBound
+	priv, err := NewPrivateKey(testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	data, err := priv.MarshalBinary()
+	noerr(t, err)
+
+	decoded := new(PrivateKey)
+	noerr(t, decoded.UnmarshalBinary(data))
+	if decoded.Opts().KeyDerivation != AddressBound {
+		t.Error("KeyDerivation not encoded")
+	}
+}
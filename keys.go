@@ -0,0 +1,399 @@
+package wotsp
+
+import (
+	"crypto"
+	cryptorand "crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"io"
+)
+
+// headerBytes is the size of the header that prefixes the binary encoding of
+// keys and signatures. The header encodes the parameters of the Opts: the
+// Mode, Tweak, Hash, N, KeyDerivation and XOF in a single byte each, followed
+// by the 32-byte Address.
+const headerBytes = 6 + 32
+
+// PrivateKey is a W-OTS+ private key. It binds the secret seed and public seed
+// to the parameters of the Opts it was created with, so that it cannot
+// accidentally be used with a different parameter set. The parameters are the
+// Mode, Tweak, Hash, N, KeyDerivation, XOF and Address.
+type PrivateKey struct {
+	seed    []byte
+	pubSeed []byte
+	opts    Opts
+}
+
+// PublicKey is a W-OTS+ public key, bound to the public seed and the
+// parameters it was generated with.
+type PublicKey struct {
+	pk      []byte
+	pubSeed []byte
+	opts    Opts
+}
+
+// Signature is a W-OTS+ signature, bound to the parameters it was created
+// with.
+type Signature struct {
+	sig  []byte
+	opts Opts
+}
+
+// GenerateKey generates a new key pair, drawing the secret seed and the public
+// seed from rand. If rand is nil, crypto/rand.Reader is used. An error is
+// returned if opts is not valid, or if rand fails to produce enough bytes.
+func GenerateKey(rand io.Reader, opts Opts) (*PrivateKey, *PublicKey, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if rand == nil {
+		rand = cryptorand.Reader
+	}
+
+	s := opts.sizes()
+
+	seed := make([]byte, s.seed)
+	if _, err := io.ReadFull(rand, seed); err != nil {
+		return nil, nil, fmt.Errorf("wotsp: reading seed: %w", err)
+	}
+
+	pubSeed := make([]byte, s.pubSeed)
+	if _, err := io.ReadFull(rand, pubSeed); err != nil {
+		return nil, nil, fmt.Errorf("wotsp: reading public seed: %w", err)
+	}
+
+	priv := &PrivateKey{seed: seed, pubSeed: pubSeed, opts: opts}
+	return priv, priv.PublicKey(), nil
+}
+
+// NewPrivateKey creates a PrivateKey from a secret seed and public seed. The
+// seeds are copied. An error is returned if opts or the seed lengths are not
+// valid.
+func NewPrivateKey(seed, pubSeed []byte, opts Opts) (*PrivateKey, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	s := opts.sizes()
+	if err := checkLength(ErrSeedLength, seed, s.seed); err != nil {
+		return nil, err
+	}
+	if err := checkLength(ErrPubSeedLength, pubSeed, s.pubSeed); err != nil {
+		return nil, err
+	}
+
+	return &PrivateKey{
+		seed:    clone(seed),
+		pubSeed: clone(pubSeed),
+		opts:    opts,
+	}, nil
+}
+
+// NewPublicKey creates a PublicKey from its raw bytes and the public seed. The
+// inputs are copied. An error is returned if opts or the input lengths are not
+// valid.
+func NewPublicKey(pk, pubSeed []byte, opts Opts) (*PublicKey, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	s := opts.sizes()
+	if err := checkLength(ErrPublicKeyLength, pk, s.publicKey); err != nil {
+		return nil, err
+	}
+	if err := checkLength(ErrPubSeedLength, pubSeed, s.pubSeed); err != nil {
+		return nil, err
+	}
+
+	return &PublicKey{
+		pk:      clone(pk),
+		pubSeed: clone(pubSeed),
+		opts:    opts,
+	}, nil
+}
+
+// NewSignature creates a Signature from its raw bytes. The signature is copied.
+// An error is returned if opts or the signature length are not valid.
+func NewSignature(sig []byte, opts Opts) (*Signature, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := checkLength(ErrSignatureLength, sig, opts.sizes().signature); err != nil {
+		return nil, err
+	}
+
+	return &Signature{sig: clone(sig), opts: opts}, nil
+}
+
+//
+// PrivateKey
+//
+
+// Seed returns a copy of the secret seed of the private key.
+func (priv *PrivateKey) Seed() []byte {
+	return clone(priv.seed)
+}
+
+// PubSeed returns a copy of the public seed of the private key.
+func (priv *PrivateKey) PubSeed() []byte {
+	return clone(priv.pubSeed)
+}
+
+// Opts returns the Opts the private key is used with.
+func (priv *PrivateKey) Opts() Opts {
+	return priv.opts
+}
+
+// Public returns the *PublicKey corresponding to priv. The public key is
+// computed on every call, which requires the evaluation of all hash chains.
+func (priv *PrivateKey) Public() crypto.PublicKey {
+	return priv.PublicKey()
+}
+
+// PublicKey computes the public key corresponding to priv.
+func (priv *PrivateKey) PublicKey() *PublicKey {
+	return &PublicKey{
+		pk:      GenPublicKey(priv.seed, priv.pubSeed, priv.opts),
+		pubSeed: clone(priv.pubSeed),
+		opts:    priv.opts,
+	}
+}
+
+// Sign signs msg, which must be exactly n bytes long, using the private key.
+//
+// Note that a W-OTS+ private key can only be used to sign a single message
+// securely. Use OneTimeKey to have this enforced.
+func (priv *PrivateKey) Sign(msg []byte) (*Signature, error) {
+	sig, err := SignChecked(msg, priv.seed, priv.pubSeed, priv.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signature{sig: sig, opts: priv.opts}, nil
+}
+
+// SignMessage signs a message of arbitrary length using the private key, as
+// in the package level SignMessage.
+func (priv *PrivateKey) SignMessage(rand io.Reader, msg []byte) ([]byte, error) {
+	return SignMessage(rand, msg, priv.seed, priv.pubSeed, priv.opts)
+}
+
+// Equal reports whether priv and x have the same value. The seeds are compared
+// in constant time.
+func (priv *PrivateKey) Equal(x crypto.PrivateKey) bool {
+	xx, ok := x.(*PrivateKey)
+	if !ok {
+		return false
+	}
+
+	return priv.opts.binding() == xx.opts.binding() &&
+		subtle.ConstantTimeCompare(priv.seed, xx.seed) == 1 &&
+		subtle.ConstantTimeCompare(priv.pubSeed, xx.pubSeed) == 1
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding consists of
+// the parameters, followed by the secret seed and public seed.
+func (priv *PrivateKey) MarshalBinary() ([]byte, error) {
+	out := make([]byte, 0, headerBytes+len(priv.seed)+len(priv.pubSeed))
+	out = appendHeader(out, priv.opts)
+	out = append(out, priv.seed...)
+	out = append(out, priv.pubSeed...)
+
+	return out, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. The Concurrency and
+// Context of the private key's Opts are left untouched.
+func (priv *PrivateKey) UnmarshalBinary(data []byte) error {
+	opts, rest, err := parseHeader(data, priv.opts)
+	if err != nil {
+		return err
+	}
+
+	s := opts.sizes()
+	if len(rest) != s.seed+s.pubSeed {
+		return fmt.Errorf("%w: private key must be %d bytes, got %d", ErrInvalidEncoding, headerBytes+s.seed+s.pubSeed, len(data))
+	}
+
+	priv.seed = clone(rest[:s.seed])
+	priv.pubSeed = clone(rest[s.seed:])
+	priv.opts = opts
+
+	return nil
+}
+
+//
+// PublicKey
+//
+
+// Bytes returns a copy of the raw public key.
+func (pub *PublicKey) Bytes() []byte {
+	return clone(pub.pk)
+}
+
+// PubSeed returns a copy of the public seed of the public key.
+func (pub *PublicKey) PubSeed() []byte {
+	return clone(pub.pubSeed)
+}
+
+// Opts returns the Opts the public key is used with.
+func (pub *PublicKey) Opts() Opts {
+	return pub.opts
+}
+
+// Verify checks whether sig is a valid signature of msg for the public key. It
+// returns false if sig was created for different parameters than the public
+// key, or if msg has the wrong length.
+func (pub *PublicKey) Verify(msg []byte, sig *Signature) bool {
+	if sig == nil || pub.opts.binding() != sig.opts.binding() {
+		return false
+	}
+
+	ok, err := VerifyChecked(pub.pk, sig.sig, msg, pub.pubSeed, pub.opts)
+	return err == nil && ok
+}
+
+// VerifyMessage checks whether sig is a signature of msg created by
+// SignMessage for the public key.
+func (pub *PublicKey) VerifyMessage(msg, sig []byte) bool {
+	return VerifyMessage(pub.pk, sig, msg, pub.pubSeed, pub.opts)
+}
+
+// Equal reports whether pub and x have the same value.
+func (pub *PublicKey) Equal(x crypto.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+
+	return pub.opts.binding() == xx.opts.binding() &&
+		subtle.ConstantTimeCompare(pub.pk, xx.pk) == 1 &&
+		subtle.ConstantTimeCompare(pub.pubSeed, xx.pubSeed) == 1
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding consists of
+// the parameters, followed by the public seed and the public key.
+func (pub *PublicKey) MarshalBinary() ([]byte, error) {
+	out := make([]byte, 0, headerBytes+len(pub.pubSeed)+len(pub.pk))
+	out = appendHeader(out, pub.opts)
+	out = append(out, pub.pubSeed...)
+	out = append(out, pub.pk...)
+
+	return out, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. The Concurrency and
+// Context of the public key's Opts are left untouched.
+func (pub *PublicKey) UnmarshalBinary(data []byte) error {
+	opts, rest, err := parseHeader(data, pub.opts)
+	if err != nil {
+		return err
+	}
+
+	s := opts.sizes()
+	if len(rest) != s.pubSeed+s.publicKey {
+		return fmt.Errorf("%w: public key must be %d bytes, got %d", ErrInvalidEncoding, headerBytes+s.pubSeed+s.publicKey, len(data))
+	}
+
+	pub.pubSeed = clone(rest[:s.pubSeed])
+	pub.pk = clone(rest[s.pubSeed:])
+	pub.opts = opts
+
+	return nil
+}
+
+//
+// Signature
+//
+
+// Bytes returns a copy of the raw signature.
+func (sig *Signature) Bytes() []byte {
+	return clone(sig.sig)
+}
+
+// Opts returns the Opts the signature was created with.
+func (sig *Signature) Opts() Opts {
+	return sig.opts
+}
+
+// Equal reports whether sig and x have the same value.
+func (sig *Signature) Equal(x *Signature) bool {
+	return x != nil &&
+		sig.opts.binding() == x.opts.binding() &&
+		subtle.ConstantTimeCompare(sig.sig, x.sig) == 1
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding consists of
+// the parameters, followed by the raw signature.
+func (sig *Signature) MarshalBinary() ([]byte, error) {
+	out := make([]byte, 0, headerBytes+len(sig.sig))
+	out = appendHeader(out, sig.opts)
+	out = append(out, sig.sig...)
+
+	return out, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. The Concurrency and
+// Context of the signature's Opts are left untouched.
+func (sig *Signature) UnmarshalBinary(data []byte) error {
+	opts, rest, err := parseHeader(data, sig.opts)
+	if err != nil {
+		return err
+	}
+
+	s := opts.sizes()
+	if len(rest) != s.signature {
+		return fmt.Errorf("%w: signature must be %d bytes, got %d", ErrInvalidEncoding, headerBytes+s.signature, len(data))
+	}
+
+	sig.sig = clone(rest)
+	sig.opts = opts
+
+	return nil
+}
+
+//
+// Encoding helpers
+//
+
+// appendHeader appends the encoded parameters of opts.
+func appendHeader(out []byte, opts Opts) []byte {
+	b := opts.binding()
+	out = append(out, byte(b.Mode), byte(b.Tweak), byte(b.Hash), byte(b.N), byte(b.KeyDerivation), byte(b.XOF))
+	return append(out, b.Address[:]...)
+}
+
+// parseHeader decodes the parameters from the header of data, and returns the
+// resulting Opts together with the remainder of data. The fields of the
+// decoded Opts that are not part of the encoding are taken from base.
+func parseHeader(data []byte, base Opts) (opts Opts, rest []byte, err error) {
+	if len(data) < headerBytes {
+		return opts, nil, fmt.Errorf("%w: input too short", ErrInvalidEncoding)
+	}
+
+	opts = base
+	opts.Mode = Mode(data[0])
+	opts.Tweak = Tweak(data[1])
+	opts.Hash = crypto.Hash(data[2])
+	opts.N = int(data[3])
+	opts.KeyDerivation = KeyDerivation(data[4])
+	opts.XOF = XOF(data[5])
+	copy(opts.Address[:], data[6:headerBytes])
+
+	if err = opts.validate(); err != nil {
+		return opts, nil, fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+
+	return opts, data[headerBytes:], nil
+}
+
+// clone returns a copy of b.
+func clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	return append([]byte(nil), b...)
+}
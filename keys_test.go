@@ -0,0 +1,141 @@
+package wotsp
+
+import (
+	"bytes"
+	"encoding"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = (*PrivateKey)(nil)
+	_ encoding.BinaryUnmarshaler = (*PrivateKey)(nil)
+	_ encoding.BinaryMarshaler   = (*PublicKey)(nil)
+	_ encoding.BinaryUnmarshaler = (*PublicKey)(nil)
+	_ encoding.BinaryMarshaler   = (*Signature)(nil)
+	_ encoding.BinaryUnmarshaler = (*Signature)(nil)
+)
+
+// TestKeys verifies the typed keys against the reference implementation, and
+// checks that the binary encodings round trip.
+func TestKeys(t *testing.T) {
+	var opts Opts
+	opts.Mode = W16
+
+	priv, err := NewPrivateKey(testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	pub := priv.PublicKey()
+	if !bytes.Equal(pub.Bytes(), testdata.PubKey) {
+		t.Error("Wrong key")
+	}
+
+	sig, err := priv.Sign(testdata.Message)
+	noerr(t, err)
+	if !bytes.Equal(sig.Bytes(), testdata.Signature) {
+		t.Error("Wrong signature")
+	}
+
+	if !pub.Verify(testdata.Message, sig) {
+		t.Error("Valid signature rejected")
+	}
+
+	privBytes, err := priv.MarshalBinary()
+	noerr(t, err)
+	priv2 := new(PrivateKey)
+	noerr(t, priv2.UnmarshalBinary(privBytes))
+	if !priv.Equal(priv2) {
+		t.Error("Private key does not round trip")
+	}
+
+	pubBytes, err := pub.MarshalBinary()
+	noerr(t, err)
+	pub2 := new(PublicKey)
+	noerr(t, pub2.UnmarshalBinary(pubBytes))
+	if !pub.Equal(pub2) || !pub2.Equal(priv.Public()) {
+		t.Error("Public key does not round trip")
+	}
+
+	sigBytes, err := sig.MarshalBinary()
+	noerr(t, err)
+	sig2 := new(Signature)
+	noerr(t, sig2.UnmarshalBinary(sigBytes))
+	if !sig.Equal(sig2) {
+		t.Error("Signature does not round trip")
+	}
+
+	err = sig2.UnmarshalBinary(sigBytes[:len(sigBytes)-1])
+	if !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("expected error [%v], got [%v]", ErrInvalidEncoding, err)
+	}
+}
+
+// TestKeysBinding verifies that a signature is rejected by a public key bound
+// to different options.
+func TestKeysBinding(t *testing.T) {
+	var opts Opts
+	opts.Mode = W16
+
+	priv, err := NewPrivateKey(testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+	sig, err := priv.Sign(testdata.Message)
+	noerr(t, err)
+
+	opts.Address[0] = 1
+	pub, err := NewPublicKey(testdata.PubKey, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	if pub.Verify(testdata.Message, sig) {
+		t.Error("Signature accepted for a different address")
+	}
+
+	opts.Address[0] = 0
+	opts.Mode = W4
+	if _, err := NewPublicKey(testdata.PubKey, testdata.PubSeed, opts); !errors.Is(err, ErrPublicKeyLength) {
+		t.Errorf("expected error [%v], got [%v]", ErrPublicKeyLength, err)
+	}
+}
+
+// TestGenerateKey verifies that GenerateKey draws its seeds from the given
+// reader, and fails when the reader runs out.
+func TestGenerateKey(t *testing.T) {
+	var opts Opts
+	opts.Mode = W16
+
+	seeds := append(append([]byte{}, testdata.Seed...), testdata.PubSeed...)
+
+	priv, pub, err := GenerateKey(bytes.NewReader(seeds), opts)
+	noerr(t, err)
+
+	if !bytes.Equal(priv.Seed(), testdata.Seed) || !bytes.Equal(priv.PubSeed(), testdata.PubSeed) {
+		t.Error("Seeds not drawn from reader")
+	}
+	if !bytes.Equal(pub.Bytes(), testdata.PubKey) {
+		t.Error("Wrong key")
+	}
+
+	_, _, err = GenerateKey(bytes.NewReader(seeds[:len(seeds)-1]), opts)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("expected error [%v], got [%v]", io.ErrUnexpectedEOF, err)
+	}
+}
+
+// TestGenerateKeyRandom verifies that keys generated from crypto/rand.Reader
+// sign and verify.
+func TestGenerateKeyRandom(t *testing.T) {
+	priv, pub, err := GenerateKey(nil, Opts{})
+	noerr(t, err)
+
+	if !bytes.Equal(GenPublicKey(priv.Seed(), priv.PubSeed(), Opts{}), pub.Bytes()) {
+		t.Error("Wrong key")
+	}
+
+	sig, err := priv.Sign(testdata.Message)
+	noerr(t, err)
+	if !pub.Verify(testdata.Message, sig) {
+		t.Error("Valid signature rejected")
+	}
+}
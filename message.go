@@ -0,0 +1,217 @@
+package wotsp
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// The functions in this file sign and verify messages of arbitrary length. The
+// message is first compressed to an n-byte digest using the randomized message
+// hash H_msg from RFC 8391:
+//
+//	H_msg(KEY, M) = H(toByte(2, n) || KEY || M),
+//	KEY           = r || root || toByte(idx, n)
+//
+// where r is an n-byte randomizer that is included in the signature. As there
+// is no XMSS tree root for a single W-OTS+ key, the public seed takes the
+// place of root, and idx is the OTS address of Opts.Address. For n = 24, the
+// prefix is shortened to toByte(2, 4) and the output of H is truncated to n
+// bytes, as in NIST SP 800-208.
+//
+// Similar to pure signing in FIPS 205, the message is prefixed with the
+// Opts.Context string before it is hashed:
+//
+//	M' = toByte(0, 1) || toByte(len(ctx), 1) || ctx || M
+//
+// so signatures created under one context never verify under another. The
+// prefix is included even if the context is empty, so that such messages stay
+// separated from those signed under a context and from pre-hashed messages.
+// H_msg is therefore never computed over the bare message M as in RFC 8391.
+//
+// The digest is then signed using Sign, so a message signature is the
+// randomizer followed by a regular W-OTS+ signature:
+//
+//	sig = r || Sign(H_msg(KEY, M'), seed, pubSeed, opts)
+//
+// The *Reader variants read the message from an io.Reader and hash it while it
+// is streamed, so large messages never have to be held in memory.
+
+// SignMessage signs a message of arbitrary length using the private key
+// generated from seed. The n-byte randomizer is read from rand, or from
+// crypto/rand.Reader if rand is nil. An error is returned if opts or the seed
+// lengths are invalid, or if rand fails.
+func SignMessage(rand io.Reader, msg, seed, pubSeed []byte, opts Opts) ([]byte, error) {
+	return SignReader(rand, bytes.NewReader(msg), seed, pubSeed, opts)
+}
+
+// PublicKeyFromMessageSig computes the public key from a signature created by
+// SignMessage. An error is returned if opts or the input lengths are invalid.
+func PublicKeyFromMessageSig(sig, msg, pubSeed []byte, opts Opts) ([]byte, error) {
+	return PublicKeyFromReaderSig(sig, bytes.NewReader(msg), pubSeed, opts)
+}
+
+// VerifyMessage checks whether sig is a signature of msg created by
+// SignMessage for the public key pk. Malformed inputs are reported as an
+// invalid signature.
+func VerifyMessage(pk, sig, msg, pubSeed []byte, opts Opts) bool {
+	ok, err := VerifyReader(pk, sig, bytes.NewReader(msg), pubSeed, opts)
+	return err == nil && ok
+}
+
+// SignReader is like SignMessage, but reads the message from msg until EOF.
+// The message is hashed as it is read, so it is never buffered in memory. The
+// resulting signature is identical to that of SignMessage over the same bytes
+// and randomizer. An error is returned if reading from msg fails.
+func SignReader(rand io.Reader, msg io.Reader, seed, pubSeed []byte, opts Opts) ([]byte, error) {
+	return signMsg(rand, domainPure, msg, seed, pubSeed, opts)
+}
+
+// PublicKeyFromReaderSig is like PublicKeyFromMessageSig, but reads the
+// message from msg until EOF. An error is returned if reading from msg fails.
+func PublicKeyFromReaderSig(sig []byte, msg io.Reader, pubSeed []byte, opts Opts) ([]byte, error) {
+	return publicKeyFromMsgSig(sig, domainPure, msg, pubSeed, opts)
+}
+
+// VerifyReader is like VerifyMessage, but reads the message from msg until
+// EOF. Unlike VerifyMessage, it returns an error for malformed inputs or if
+// reading from msg fails; an invalid signature is reported as (false, nil).
+func VerifyReader(pk, sig []byte, msg io.Reader, pubSeed []byte, opts Opts) (bool, error) {
+	return verifyMsg(pk, sig, domainPure, msg, pubSeed, opts)
+}
+
+// Domain separators that distinguish the encoded message M' of pure signatures
+// from that of pre-hash signatures.
+const (
+	domainPure    byte = 0
+	domainPreHash byte = 1
+)
+
+// signMsg signs the message read from msg, encoded as M' using the given domain
+// separator.
+func signMsg(rand io.Reader, domain byte, msg io.Reader, seed, pubSeed []byte, opts Opts) ([]byte, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	s := opts.sizes()
+	if err := checkLength(ErrSeedLength, seed, s.seed); err != nil {
+		return nil, err
+	}
+	if err := checkLength(ErrPubSeedLength, pubSeed, s.pubSeed); err != nil {
+		return nil, err
+	}
+
+	r, err := randomizer(rand, opts.n())
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := msgDigest(r, pubSeed, domain, msg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := sign(digest, seed, pubSeed, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(r, sig...), nil
+}
+
+// publicKeyFromMsgSig computes the public key from a signature over the
+// message read from msg, encoded as M' using the given domain separator.
+func publicKeyFromMsgSig(sig []byte, domain byte, msg io.Reader, pubSeed []byte, opts Opts) ([]byte, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	s, n := opts.sizes(), opts.n()
+	if err := checkLength(ErrSignatureLength, sig, n+s.signature); err != nil {
+		return nil, err
+	}
+	if err := checkLength(ErrPubSeedLength, pubSeed, s.pubSeed); err != nil {
+		return nil, err
+	}
+
+	digest, err := msgDigest(sig[:n], pubSeed, domain, msg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return PublicKeyFromSig(sig[n:], digest, pubSeed, opts), nil
+}
+
+// verifyMsg checks a signature over the message read from msg, encoded as M'
+// using the given domain separator.
+func verifyMsg(pk, sig []byte, domain byte, msg io.Reader, pubSeed []byte, opts Opts) (bool, error) {
+	if err := opts.validate(); err != nil {
+		return false, err
+	}
+
+	if err := checkLength(ErrPublicKeyLength, pk, opts.sizes().publicKey); err != nil {
+		return false, err
+	}
+
+	pubKeyFromSig, err := publicKeyFromMsgSig(sig, domain, msg, pubSeed, opts)
+	if err != nil {
+		return false, err
+	}
+
+	return verifyPublicKey(pk, pubKeyFromSig), nil
+}
+
+// randomizer reads an n-byte message randomizer from rand, or from
+// crypto/rand.Reader if rand is nil.
+func randomizer(rand io.Reader, n int) ([]byte, error) {
+	if rand == nil {
+		rand = cryptorand.Reader
+	}
+
+	r := make([]byte, n)
+	if _, err := io.ReadFull(rand, r); err != nil {
+		return nil, fmt.Errorf("wotsp: reading randomizer: %w", err)
+	}
+
+	return r, nil
+}
+
+// msgDigest computes H_msg for the randomizer r over the message read from msg,
+// encoded as M' using the given domain separator.
+func msgDigest(r, pubSeed []byte, domain byte, msg io.Reader, opts Opts) ([]byte, error) {
+	h := newMsgHash(r, pubSeed, domain, opts)
+	if _, err := io.Copy(h, msg); err != nil {
+		return nil, fmt.Errorf("wotsp: reading message: %w", err)
+	}
+
+	return h.Sum(nil)[:opts.n()], nil
+}
+
+// newMsgHash returns a hash.Hash that computes H_msg for the randomizer r,
+// after the message has been written to it. The prefix of M', consisting of
+// the domain separator and the context, is written to the hash as well. Opts
+// must be valid.
+func newMsgHash(r, pubSeed []byte, domain byte, opts Opts) hash.Hash {
+	n, pad := opts.n(), opts.pad()
+
+	prefix := make([]byte, pad)
+	binary.BigEndian.PutUint16(prefix[pad-2:], uint16(2))
+
+	index := make([]byte, n)
+	binary.BigEndian.PutUint32(index[n-4:], Address(opts.Address).OTS())
+
+	h := opts.newHash()()
+	h.Write(prefix)
+	h.Write(r)
+	h.Write(pubSeed)
+	h.Write(index)
+
+	h.Write([]byte{domain, byte(len(opts.Context))})
+	io.WriteString(h, opts.Context)
+
+	return h
+}
@@ -0,0 +1,189 @@
+package wotsp
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+// TestSignMessage verifies signing and verification of messages of various
+// lengths for all parameter sets.
+func TestSignMessage(t *testing.T) {
+	msgs := [][]byte{
+		nil,
+		[]byte("short"),
+		testdata.Message,
+		bytes.Repeat([]byte("long message "), 1000),
+	}
+
+	for _, mode := range []Mode{W4, W16, W256} {
+		var opts Opts
+		opts.Mode = mode
+		opts.Address[19] = 7
+
+		priv, pub, err := GenerateKey(nil, opts)
+		noerr(t, err)
+
+		for _, msg := range msgs {
+			t.Run(fmt.Sprintf("%s-%d", mode, len(msg)), func(t *testing.T) {
+				sig, err := priv.SignMessage(nil, msg)
+				noerr(t, err)
+
+				if len(sig) != opts.MessageSignatureBytes() {
+					t.Errorf("wrong signature length %d", len(sig))
+				}
+
+				if !pub.VerifyMessage(msg, sig) {
+					t.Error("Valid signature rejected")
+				}
+
+				if pub.VerifyMessage(append(msg, 0), sig) {
+					t.Error("Signature accepted for a different message")
+				}
+
+				sig[0] ^= 1
+				if pub.VerifyMessage(msg, sig) {
+					t.Error("Signature accepted with a modified randomizer")
+				}
+			})
+		}
+	}
+}
+
+// TestSignMessageDeterministic verifies that the signature is fully
+// determined by the randomizer, and that the randomizer is carried in the
+// signature.
+func TestSignMessageDeterministic(t *testing.T) {
+	var opts Opts
+	r := bytes.Repeat([]byte{0xab}, N)
+
+	sig1, err := SignMessage(bytes.NewReader(r), testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+	sig2, err := SignMessage(bytes.NewReader(r), testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	if !bytes.Equal(sig1, sig2) || !bytes.Equal(sig1[:N], r) {
+		t.Error("Signature not determined by randomizer")
+	}
+
+	_, err = PublicKeyFromMessageSig(sig1[1:], testdata.Message, testdata.PubSeed, opts)
+	if !errors.Is(err, ErrSignatureLength) {
+		t.Errorf("expected error [%v], got [%v]", ErrSignatureLength, err)
+	}
+}
+
+// errReader is an io.Reader that fails after returning its data.
+type errReader struct {
+	data []byte
+	err  error
+}
+
+func (r *errReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, r.err
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// TestSignReader verifies that streaming signatures are interchangeable with
+// signatures over the buffered message, and that read errors are reported.
+func TestSignReader(t *testing.T) {
+	var opts Opts
+	r := bytes.Repeat([]byte{0xcd}, N)
+	msg := bytes.Repeat([]byte("streamed "), 100000)
+
+	pubKey := GenPublicKey(testdata.Seed, testdata.PubSeed, opts)
+
+	sig, err := SignReader(bytes.NewReader(r), bytes.NewReader(msg), testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	buffered, err := SignMessage(bytes.NewReader(r), msg, testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+	if !bytes.Equal(sig, buffered) {
+		t.Error("Streamed signature differs from buffered signature")
+	}
+
+	ok, err := VerifyReader(pubKey, sig, bytes.NewReader(msg), testdata.PubSeed, opts)
+	noerr(t, err)
+	if !ok {
+		t.Error("Valid signature rejected")
+	}
+
+	errRead := errors.New("read failed")
+	_, err = VerifyReader(pubKey, sig, &errReader{data: msg, err: errRead}, testdata.PubSeed, opts)
+	if !errors.Is(err, errRead) {
+		t.Errorf("expected error [%v], got [%v]", errRead, err)
+	}
+}
+
+// TestSignMessageContext verifies that signatures only verify under the
+// context they were created with.
+func TestSignMessageContext(t *testing.T) {
+	var opts Opts
+	opts.Context = "protocol A"
+
+	pubKey := GenPublicKey(testdata.Seed, testdata.PubSeed, opts)
+
+	sig, err := SignMessage(nil, testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	if !VerifyMessage(pubKey, sig, testdata.Message, testdata.PubSeed, opts) {
+		t.Error("Valid signature rejected")
+	}
+
+	for _, ctx := range []string{"", "protocol B", "protocol A "} {
+		other := opts
+		other.Context = ctx
+		if VerifyMessage(pubKey, sig, testdata.Message, testdata.PubSeed, other) {
+			t.Errorf("Signature accepted under context %q", ctx)
+		}
+	}
+
+	opts.Context = string(make([]byte, MaxContextBytes+1))
+	_, err = SignMessage(nil, testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	if !errors.Is(err, ErrContextLength) {
+		t.Errorf("expected error [%v], got [%v]", ErrContextLength, err)
+	}
+}
+
+// TestMsgDigest verifies H_msg, including the encoding of M' with and without
+// a context, against known answers.
+func TestMsgDigest(t *testing.T) {
+	cases := []struct {
+		ctx    string
+		digest string
+	}{
+		{"", "9a1ec45ce95c3f4d17c44c82d620919a5d4c35dc827537e59666aef7fa9c91fd"},
+		{"ctx", "7af3488604dd1e12acfd35e4b8ebb04efbe17fde0199454206e0f3727f6de7be"},
+	}
+
+	r := make([]byte, N)
+	pubSeed := make([]byte, N)
+	for i := range r {
+		r[i] = byte(i)
+		pubSeed[i] = byte(N + i)
+	}
+
+	for _, c := range cases {
+		var opts Opts
+		opts.Address[19] = 7 // OTS address
+		opts.Context = c.ctx
+
+		sig, err := SignMessage(bytes.NewReader(r), []byte("message"), testdata.Seed, pubSeed, opts)
+		noerr(t, err)
+
+		digest, err := hex.DecodeString(c.digest)
+		noerr(t, err)
+
+		if !bytes.Equal(sig[N:], Sign(digest, testdata.Seed, pubSeed, opts)) {
+			t.Errorf("context %q: wrong message digest", c.ctx)
+		}
+	}
+}
@@ -2,22 +2,19 @@ package wotsp
 
 import (
 	"fmt"
-)
 
-// params is an internal struct that defines required parameters in WOTS. The
-// parameters are derived from a Mode.
-type params struct {
-	w         uint
-	logW      uint
-	l1, l2, l int
-}
+	"github.com/lentus/wotsp/primitives"
+)
 
 // Mode constants specify internal parameters according to the given mode of
-// operation. The available parameter sets include w = 4 and w = 16. The
-// default, which is used when no explicit mode is chosen, is w = 16. This
-// allows the default Mode to be selected by specifying wotsp.Mode(0).
+// operation. The available parameter sets include every w = 2^k for k = 1 to
+// 8. The default, which is used when no explicit mode is chosen, is w = 16.
+// This allows the default Mode to be selected by specifying wotsp.Mode(0).
 //
-// See RFC 8391 for details on the different parameter sets.
+// A larger w results in smaller signatures at the cost of longer hash chains,
+// and thus slower operations. RFC 8391 only defines w = 16, the other modes
+// follow the same construction with l1 and l2 computed for the given w. See
+// RFC 8391 for details on the different parameter sets.
 type Mode int
 
 const (
@@ -33,45 +30,98 @@ const (
 
 	// W256 indicates the parameter set of W-OTS+ where w = 256.
 	W256
+
+	// W2 indicates the parameter set of W-OTS+ where w = 2.
+	W2
+
+	// W8 indicates the parameter set of W-OTS+ where w = 8.
+	W8
+
+	// W32 indicates the parameter set of W-OTS+ where w = 32.
+	W32
+
+	// W64 indicates the parameter set of W-OTS+ where w = 64.
+	W64
+
+	// W128 indicates the parameter set of W-OTS+ where w = 128.
+	W128
 )
 
-// params construct a modeParams instance based on the operating Mode, or an
-// error if the mode is not valid.
-func (m Mode) params() (p params) {
-	switch m {
-	case W4:
-		p.w = 4
-		p.logW = 2
-		p.l1 = 128
-		p.l2 = 5
-	case W16:
-		p.w = 16
-		p.logW = 4
-		p.l1 = 64
-		p.l2 = 3
-	case W256:
-		p.w = 256
-		p.logW = 8
-		p.l1 = 32
-		p.l2 = 2
-	default:
-		panic(fmt.Sprintf("invalid mode %s, must be either wotsp.W4, wotsp.W16 or wotsp.W256", m))
+// logW maps each Mode to the base 2 logarithm of its Winternitz parameter.
+var logW = map[Mode]uint{
+	W2:   1,
+	W4:   2,
+	W8:   3,
+	W16:  4,
+	W32:  5,
+	W64:  6,
+	W128: 7,
+	W256: 8,
+}
+
+// ModeForW returns the Mode with Winternitz parameter w. An error wrapping
+// ErrInvalidMode is returned if w is not a power of two between 2 and 256.
+func ModeForW(w int) (Mode, error) {
+	for m, lw := range logW {
+		if w == 1<<lw {
+			return m, nil
+		}
 	}
 
-	p.l = p.l1 + p.l2
-	return
+	return 0, fmt.Errorf("%w: no mode for w = %d, must be a power of two between 2 and 256", ErrInvalidMode, w)
+}
+
+// params construct a primitives.Params instance based on the operating Mode and
+// the security parameter n. It panics if the mode is not valid.
+func (m Mode) params(n int) primitives.Params {
+	p, err := m.checkedParams(n)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return p
+}
+
+// checkedParams construct a primitives.Params instance based on the operating
+// Mode and the security parameter n, or an error if the mode is not valid. n
+// is assumed to be valid.
+func (m Mode) checkedParams(n int) (primitives.Params, error) {
+	lw, ok := logW[m]
+	if !ok {
+		return primitives.Params{}, fmt.Errorf("%w %s, must be one of wotsp.W2 to wotsp.W256", ErrInvalidMode, m)
+	}
+
+	return primitives.NewParams(n, lw), nil
+}
+
+// sizes groups the expected lengths of the inputs and outputs of W-OTS+
+// operations for a single Mode and security parameter n.
+type sizes struct {
+	publicKey int
+	signature int
+	seed      int
+	pubSeed   int
+}
+
+// sizes returns the expected input and output lengths for the Mode and the
+// security parameter n. The mode is assumed to be valid.
+func (m Mode) sizes(n int) sizes {
+	l := m.params(n).L
+
+	return sizes{
+		publicKey: l * n,
+		signature: l * n,
+		seed:      n,
+		pubSeed:   n,
+	}
 }
 
 // String implements fmt.Stringer.
 func (m Mode) String() string {
-	switch m {
-	case W4:
-		return "W4"
-	case W16:
-		return "W16"
-	case W256:
-		return "W256"
-	default:
+	lw, ok := logW[m]
+	if !ok {
 		return fmt.Sprintf("<invalid mode %d>", m)
 	}
+
+	return fmt.Sprintf("W%d", 1<<lw)
 }
@@ -0,0 +1,67 @@
+package wotsp
+
+import (
+	"fmt"
+
+	"github.com/lentus/wotsp/primitives"
+)
+
+// Operation identifies a W-OTS+ operation reported to an Observer.
+type Operation int
+
+const (
+	// OperationGenPublicKey is the computation of a public key from a seed.
+	OperationGenPublicKey Operation = iota
+
+	// OperationSign is the computation of a signature.
+	OperationSign
+
+	// OperationPublicKeyFromSig is the computation of a public key from a
+	// signature, which is the bulk of verification.
+	OperationPublicKeyFromSig
+
+	// OperationCompress is the compression of a public key using the L-tree.
+	OperationCompress
+)
+
+// String implements fmt.Stringer.
+func (op Operation) String() string {
+	switch op {
+	case OperationGenPublicKey:
+		return "GenPublicKey"
+	case OperationSign:
+		return "Sign"
+	case OperationPublicKeyFromSig:
+		return "PublicKeyFromSig"
+	case OperationCompress:
+		return "Compress"
+	default:
+		return fmt.Sprintf("<invalid operation %d>", int(op))
+	}
+}
+
+// Stats holds the hash function evaluations, the compression function calls
+// and the work per routine of a single operation. See primitives.Stats for
+// details.
+type Stats = primitives.Stats
+
+// Observer receives the Stats of every W-OTS+ operation when set as
+// Opts.Observer. Operations that build on others report each of them
+// separately: a verification reports OperationPublicKeyFromSig, and a
+// signature created with Opts.VerifyAfterSign additionally reports
+// OperationGenPublicKey and OperationPublicKeyFromSig. The evaluations of
+// H_msg by SignMessage and friends are not included.
+//
+// Observe is called once the operation is complete, from the goroutine that
+// started it.
+type Observer interface {
+	Observe(op Operation, stats Stats)
+}
+
+// observe reports the Stats collected by h to the Observer of the Opts, if
+// any.
+func (o Opts) observe(op Operation, h *primitives.Hasher) {
+	if o.Observer != nil {
+		o.Observer.Observe(op, h.Stats())
+	}
+}
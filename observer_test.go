@@ -0,0 +1,110 @@
+package wotsp
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+// statsRecorder is an Observer that records the Stats of every operation.
+type statsRecorder struct {
+	mu    sync.Mutex
+	ops   []Operation
+	stats []Stats
+}
+
+func (r *statsRecorder) Observe(op Operation, stats Stats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ops = append(r.ops, op)
+	r.stats = append(r.stats, stats)
+}
+
+// TestObserver verifies the hash function evaluations reported for each
+// operation against the expected counts of the parameter set.
+func TestObserver(t *testing.T) {
+	rec := new(statsRecorder)
+
+	var opts Opts
+	opts.Concurrency = 4
+	opts.Observer = rec
+
+	pubKey := GenPublicKey(testdata.Seed, testdata.PubSeed, opts)
+	sig := Sign(testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	if !Verify(pubKey, sig, testdata.Message, testdata.PubSeed, opts) {
+		t.Fatal("Valid signature rejected")
+	}
+	_, err := CompressPublicKey(pubKey, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	expected := []Operation{OperationGenPublicKey, OperationSign, OperationPublicKeyFromSig, OperationCompress}
+	if len(rec.ops) != len(expected) {
+		t.Fatalf("observed operations %v, expected %v", rec.ops, expected)
+	}
+	for i, op := range expected {
+		if rec.ops[i] != op {
+			t.Errorf("observed operations %v, expected %v", rec.ops, expected)
+		}
+	}
+
+	p, err := ParamSetByOID(OIDSHA2_256)
+	noerr(t, err)
+	calls := p.HashCalls()
+
+	gen, signed, fromSig := rec.stats[0], rec.stats[1], rec.stats[2]
+	if gen.PRF+gen.F != calls.GenPublicKey || gen.F != 67*15 || gen.H != 0 {
+		t.Errorf("wrong counts for GenPublicKey: %+v", gen)
+	}
+	if signed.F+fromSig.F != gen.F || signed.PRF+fromSig.PRF != gen.PRF {
+		t.Errorf("counts of Sign and PublicKeyFromSig do not add up to GenPublicKey")
+	}
+
+	// With SHA-256 and n = 32, F takes two compressions and PRF one
+	if gen.Compressions != gen.PRF+2*gen.F {
+		t.Errorf("wrong compression count %d for GenPublicKey", gen.Compressions)
+	}
+
+	chains := 0
+	for _, r := range gen.Routines {
+		chains += r.Chains
+	}
+	if len(gen.Routines) != 4 || chains != 67 {
+		t.Errorf("wrong routine stats %+v", gen.Routines)
+	}
+
+	// The L-tree combines 67 nodes into one using 66 evaluations of H
+	if compress := rec.stats[3]; compress.H != 66 || compress.PRF != 3*66 {
+		t.Errorf("wrong counts for Compress: %+v", compress)
+	}
+}
+
+// TestObserverCompressions verifies the compression function calls reported
+// for hash functions with different block sizes and constructions.
+func TestObserverCompressions(t *testing.T) {
+	cases := []struct {
+		oid          OID
+		compressions int
+	}{
+		// The private key costs one compression per element, and every chain
+		// step costs one compression for each PRF call and two for F
+		{OIDSHA2_256, 67 + 67*15*4},
+		{OIDSHA2_512, 131 + 131*15*4},
+		// A single permutation suffices for every call of SHAKE256
+		{OIDSHAKE256_256, 67 + 67*15*3},
+	}
+
+	for _, c := range cases {
+		p, err := ParamSetByOID(c.oid)
+		noerr(t, err)
+
+		rec := new(statsRecorder)
+		opts := p.Opts()
+		opts.Observer = rec
+
+		GenPublicKey(make([]byte, p.N), make([]byte, p.N), opts)
+		if got := rec.stats[0].Compressions; got != c.compressions {
+			t.Errorf("%s: %d compressions, expected %d", p, got, c.compressions)
+		}
+	}
+}
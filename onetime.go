@@ -0,0 +1,147 @@
+package wotsp
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// OneTimeKey is a W-OTS+ private key that can be used to sign only once. As
+// W-OTS+ is broken by a second signature under the same key, a OneTimeKey
+// wipes its secret seed when it is used to sign, and refuses any subsequent
+// signing attempts with ErrKeyUsed.
+//
+// OneTimeKey implements crypto.Signer. It is safe for concurrent use; exactly
+// one of several simultaneous Sign calls will succeed.
+type OneTimeKey struct {
+	mu   sync.Mutex
+	priv *PrivateKey
+	pub  *PublicKey
+	used bool
+}
+
+// GenerateOneTimeKey generates a new OneTimeKey, drawing its seeds from rand
+// as in GenerateKey. The secret seed is never exposed to the caller.
+func GenerateOneTimeKey(rand io.Reader, opts Opts) (*OneTimeKey, error) {
+	priv, pub, err := GenerateKey(rand, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OneTimeKey{priv: priv, pub: pub}, nil
+}
+
+// NewOneTimeKey creates a OneTimeKey from a copy of priv. Since the OneTimeKey
+// can only wipe its own copy of the secret seed, the caller should discard priv
+// afterwards.
+func NewOneTimeKey(priv *PrivateKey) *OneTimeKey {
+	k := &PrivateKey{
+		seed:    clone(priv.seed),
+		pubSeed: clone(priv.pubSeed),
+		opts:    priv.opts,
+	}
+
+	return &OneTimeKey{priv: k, pub: k.PublicKey()}
+}
+
+// Public returns the *PublicKey corresponding to the key. The public key
+// remains available after the key has been used.
+func (k *OneTimeKey) Public() crypto.PublicKey {
+	return k.PublicKey()
+}
+
+// PublicKey returns the public key corresponding to the key. The public key
+// remains available after the key has been used.
+func (k *OneTimeKey) PublicKey() *PublicKey {
+	return k.pub
+}
+
+// Used reports whether the key has been used to sign a message.
+func (k *OneTimeKey) Used() bool {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return k.used
+}
+
+// Sign implements crypto.Signer. It signs digest, which must be exactly n
+// bytes long, and consumes the key: the secret seed is wiped and all later
+// calls return ErrKeyUsed. If the inputs are invalid, an error is returned and
+// the key is not consumed. If a fault is detected through
+// Opts.VerifyAfterSign, the key is consumed without releasing a signature.
+//
+// The returned signature is the raw W-OTS+ signature, which can be bound to
+// the key's options again using NewSignature(sig, k.PublicKey().Opts()). As
+// W-OTS+ signing is deterministic, rand is ignored. If opts is an Opts or
+// *Opts, its parameters must match those of the key; other crypto.SignerOpts
+// are only used to check the length of digest, and must name an available
+// hash function.
+func (k *OneTimeKey) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.used {
+		return nil, ErrKeyUsed
+	}
+
+	if err := k.checkSignerOpts(digest, opts); err != nil {
+		return nil, err
+	}
+
+	sig, err := k.priv.Sign(digest)
+	if errors.Is(err, ErrFaultDetected) {
+		// The faulty signature was not released, but fail closed anyway
+		k.consume()
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+
+	k.consume()
+	return sig.sig, nil
+}
+
+// checkSignerOpts checks whether digest and opts are acceptable inputs for
+// Sign.
+func (k *OneTimeKey) checkSignerOpts(digest []byte, opts crypto.SignerOpts) error {
+	var wotsOpts *Opts
+	switch o := opts.(type) {
+	case Opts:
+		wotsOpts = &o
+	case *Opts:
+		if o == nil {
+			// A nil *Opts is treated like nil opts
+			opts = nil
+		}
+		wotsOpts = o
+	}
+
+	if wotsOpts != nil {
+		if err := wotsOpts.validate(); err != nil {
+			return err
+		}
+		if wotsOpts.binding() != k.priv.opts.binding() {
+			return ErrOptsMismatch
+		}
+	} else if opts != nil && opts.HashFunc() != crypto.Hash(0) {
+		h := opts.HashFunc()
+		if !h.Available() {
+			return fmt.Errorf("%w [%d]", ErrUnsupportedHash, h)
+		}
+		if h.Size() != len(digest) {
+			return fmt.Errorf("%w: digest length does not match %s", ErrMessageLength, h)
+		}
+	}
+
+	return checkLength(ErrMessageLength, digest, k.priv.opts.n())
+}
+
+// consume wipes the secret seed and marks the key as used. It must be called
+// with k.mu held.
+func (k *OneTimeKey) consume() {
+	wipe(k.priv.seed)
+	k.priv.seed = nil
+	k.used = true
+}
@@ -0,0 +1,108 @@
+package wotsp
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+var _ crypto.Signer = (*OneTimeKey)(nil)
+
+// TestOneTimeKey verifies that a OneTimeKey signs exactly once, and wipes its
+// secret seed afterwards.
+func TestOneTimeKey(t *testing.T) {
+	var opts Opts
+	opts.Mode = W16
+
+	priv, err := NewPrivateKey(testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	k := NewOneTimeKey(priv)
+	seed := k.priv.seed
+
+	if _, err := k.Sign(nil, testdata.Message[:N-1], opts); !errors.Is(err, ErrMessageLength) {
+		t.Errorf("expected error [%v], got [%v]", ErrMessageLength, err)
+	}
+	if k.Used() {
+		t.Error("Key consumed by invalid message")
+	}
+
+	sig, err := k.Sign(nil, testdata.Message, opts)
+	noerr(t, err)
+	if !bytes.Equal(sig, testdata.Signature) {
+		t.Error("Wrong signature")
+	}
+
+	if !k.Used() {
+		t.Error("Key not marked as used")
+	}
+	if !bytes.Equal(seed, make([]byte, len(seed))) {
+		t.Error("Seed not wiped")
+	}
+
+	if _, err := k.Sign(nil, testdata.Message, opts); !errors.Is(err, ErrKeyUsed) {
+		t.Errorf("expected error [%v], got [%v]", ErrKeyUsed, err)
+	}
+
+	typed, err := NewSignature(sig, k.PublicKey().Opts())
+	noerr(t, err)
+	if !k.PublicKey().Verify(testdata.Message, typed) {
+		t.Error("Valid signature rejected")
+	}
+
+	// The key passed to NewOneTimeKey is left untouched
+	if !bytes.Equal(priv.seed, testdata.Seed) {
+		t.Error("Original seed modified")
+	}
+}
+
+// TestOneTimeKeySigner verifies the handling of crypto.SignerOpts by the
+// crypto.Signer implementation of OneTimeKey.
+func TestOneTimeKeySigner(t *testing.T) {
+	var opts Opts
+	opts.Mode = W16
+
+	k, err := GenerateOneTimeKey(nil, opts)
+	noerr(t, err)
+
+	var signer crypto.Signer = k
+	if !signer.Public().(*PublicKey).Equal(k.PublicKey()) {
+		t.Error("Wrong public key")
+	}
+
+	other := opts
+	other.Mode = W4
+	if _, err := signer.Sign(nil, testdata.Message, other); !errors.Is(err, ErrOptsMismatch) {
+		t.Errorf("expected error [%v], got [%v]", ErrOptsMismatch, err)
+	}
+	if _, err := signer.Sign(nil, testdata.Message, crypto.SHA512); !errors.Is(err, ErrMessageLength) {
+		t.Errorf("expected error [%v], got [%v]", ErrMessageLength, err)
+	}
+	if _, err := signer.Sign(nil, testdata.Message, crypto.Hash(99)); !errors.Is(err, ErrUnsupportedHash) {
+		t.Errorf("expected error [%v], got [%v]", ErrUnsupportedHash, err)
+	}
+
+	sig, err := signer.Sign(nil, testdata.Message, (*Opts)(nil))
+	noerr(t, err)
+
+	if !Verify(k.PublicKey().Bytes(), sig, testdata.Message, k.PublicKey().PubSeed(), opts) {
+		t.Error("Valid signature rejected")
+	}
+}
+
+// TestOneTimeKeySignerHash verifies that a crypto.Hash is accepted as
+// crypto.SignerOpts if its digest size matches the message.
+func TestOneTimeKeySignerHash(t *testing.T) {
+	k, err := GenerateOneTimeKey(nil, Opts{})
+	noerr(t, err)
+
+	sig, err := k.Sign(nil, testdata.Message, crypto.SHA256)
+	noerr(t, err)
+
+	if !Verify(k.PublicKey().Bytes(), sig, testdata.Message, k.PublicKey().PubSeed(), Opts{}) {
+		t.Error("Valid signature rejected")
+	}
+}
@@ -3,7 +3,10 @@ package wotsp
 import (
 	"crypto"
 	"fmt"
+	"hash"
 	"runtime"
+
+	"github.com/lentus/wotsp/primitives"
 )
 
 var (
@@ -12,15 +15,42 @@ var (
 		crypto.SHA512_256:  true,
 		crypto.BLAKE2b_256: true,
 		crypto.BLAKE2s_256: true,
+		crypto.SHA512:      true,
 	}
 )
 
+// n192 is the security parameter of the 192-bit parameter sets of NIST SP
+// 800-208. These sets truncate SHA-256 to n bytes, and shorten the domain
+// separating prefixes of the keyed hash functions to 4 bytes.
+const n192 = 24
+
 // Opts groups the parameters required for W-OTS+ operations. It implements
 // crypto.SignerOpts.
 type Opts struct {
 	Mode    Mode
 	Address [32]byte
 
+	// N is the security parameter n: the length in bytes of the messages
+	// passed to Sign, of the seeds, and of the elements of keys and
+	// signatures. It must equal the digest size of Hash. The default (for 0)
+	// is 32, as per the RFC. Use the size methods of Opts, such as
+	// SignatureBytes, to obtain the resulting sizes.
+	//
+	// N = 24 selects the 192-bit parameter sets of NIST SP 800-208, which use
+	// 4-byte domain separating prefixes, and allows crypto.SHA256 as Hash,
+	// truncated to 192 bits. As these sets derive the private key using
+	// PRF_keygen, N = 24 requires KeyDerivation to be AddressBound.
+	N int
+
+	// Tweak selects the construction of the tweakable hash function used in
+	// the hash chains. The default is Robust, as per the RFC.
+	Tweak Tweak
+
+	// KeyDerivation selects how the private key is derived from the secret
+	// seed. The default is SeedCounter, as per the RFC. AddressBound selects
+	// the derivation of NIST SP 800-208.
+	KeyDerivation KeyDerivation
+
 	// Concurrency specifies the amount of goroutines to use for WOTS
 	// operations. Concurrency follows the following logic for n:
 	//  n > 0: divide chains over n goroutines.
@@ -29,33 +59,308 @@ type Opts struct {
 	//         runtime.NumCPU or runtime.GOMAXPROX(-1), whichever is lower.
 	Concurrency int
 
+	// Context is an optional context string of at most MaxContextBytes bytes
+	// that is bound into the digest of messages signed by SignMessage and
+	// SignReader. A signature only verifies under the same Context it was
+	// created with, which separates the signatures of different protocols that
+	// share the same keys. Context is not used by Sign, which signs the given
+	// n-byte message directly.
+	Context string
+
+	// Tracer, if set, receives the intermediate values of every operation: the
+	// elements of the expanded private key, the outputs of PRF and the nodes of
+	// the hash chains. It is meant for comparing transcripts with other
+	// implementations. See primitives.Tracer for details.
+	Tracer Tracer
+
+	// Observer, if set, receives the number of hash function evaluations, the
+	// compression function calls they took, and the work done by every
+	// goroutine for each operation, for capacity planning. Counting is
+	// disabled when Observer is nil.
+	Observer Observer
+
+	// VerifyAfterSign protects against fault attacks on signing. A fault
+	// injected during the computation of a hash chain results in a faulty
+	// signature that may leak secret chain values. When VerifyAfterSign is
+	// set, every fresh signature is verified by recomputing the public key
+	// both from the private key and from the signature, and the signature is
+	// discarded if they differ: the error returning functions, such as
+	// SignChecked and SignMessage, return ErrFaultDetected, and Sign returns
+	// nil. This more than doubles the cost of signing.
+	VerifyAfterSign bool
+
+	// XOF, if set, selects an extendable-output function as the hash function
+	// to use, with an output of n bytes. Hash must be left unset in that case,
+	// and n must be at least 4 and at most the block size of the XOF: 168
+	// bytes for SHAKE128 and 136 bytes for SHAKE256. The XOF must be linked
+	// into the binary by importing package github.com/lentus/wotsp/shake.
+	XOF XOF
+
 	// Hash specifies the specific hash function to use. For a hash function to
-	// be accepted by the implementation, it needs to have a digest of 256 bits.
+	// be accepted by the implementation, it needs to have a digest of n bytes.
 	//
 	// Currently, the following values are supported:
-	//	crypto.SHA256
-	//	crypto.SHA512_256
-	//	crypto.BLAKE2b_256
-	//  crypto.BLAKE2s_256
+	//	crypto.SHA256      (n = 32)
+	//	crypto.SHA512_256  (n = 32)
+	//	crypto.BLAKE2b_256 (n = 32)
+	//	crypto.BLAKE2s_256 (n = 32)
+	//	crypto.SHA256      (n = 24, truncated)
+	//	crypto.SHA512      (n = 64)
 	//
-	// The default (for crypto.Hash(0)) is SHA256, as per the RFC.
+	// The default (for crypto.Hash(0)) is SHA256, as per the RFC, unless XOF
+	// is set.
 	crypto.Hash
 
 	// NOTE by embedding Hash we automatically implement crypto.SignerOpts, if
 	// this were ever to become relevant.
 }
 
-// hash returns the hash function to use for the run of W-OTS+.
+// n returns the security parameter n, based on Opts.N.
+func (o Opts) n() int {
+	if o.N == 0 {
+		return N
+	}
+
+	return o.N
+}
+
+// hash returns the hash function to use for the run of W-OTS+ if Opts.XOF is
+// not set. It panics if Opts.Hash is not supported, or if it does not match n.
 func (o Opts) hash() crypto.Hash {
-	if o.Hash == crypto.Hash(0) {
-		return crypto.SHA256
+	h := o.Hash
+	if h == crypto.Hash(0) {
+		h = crypto.SHA256
+	}
+
+	if !canPrecompute[h] {
+		panic(fmt.Sprintf("unsupported value for Opts.Hash [%d]", o.Hash))
+	}
+
+	if !matchesN(h, o.n()) {
+		panic(fmt.Sprintf("digest size of Opts.Hash %s does not match n = %d", h, o.n()))
+	}
+
+	return h
+}
+
+// checkedHash returns the hash function to use for the run of W-OTS+, or an
+// error if Opts.Hash is not supported or not linked into the binary. If
+// Opts.XOF is set, the zero crypto.Hash is returned, or an error if the XOF
+// is not valid or Opts.Hash is set as well. n is assumed to be valid.
+func (o Opts) checkedHash() (crypto.Hash, error) {
+	if o.XOF != 0 {
+		if o.Hash != crypto.Hash(0) {
+			return 0, fmt.Errorf("%w: Opts.Hash and Opts.XOF are both set", ErrUnsupportedHash)
+		}
+
+		return 0, o.XOF.validate(o.n())
+	}
+
+	h := o.Hash
+	if h == crypto.Hash(0) {
+		h = crypto.SHA256
+	}
+
+	if !canPrecompute[h] {
+		return 0, fmt.Errorf("%w [%d]", ErrUnsupportedHash, o.Hash)
+	}
+
+	if !h.Available() {
+		return 0, fmt.Errorf("%w: %s is not linked into the binary", ErrUnsupportedHash, h)
+	}
+
+	if !matchesN(h, o.n()) {
+		return 0, fmt.Errorf("%w: %s has a %d-byte digest, expected n = %d", ErrInvalidN, h, h.Size(), o.n())
+	}
+
+	return h, nil
+}
+
+// matchesN reports whether the hash function h can be used with the security
+// parameter n: its digest must be n bytes long, or h must be SHA-256 truncated
+// to the 192 bits of NIST SP 800-208.
+func matchesN(h crypto.Hash, n int) bool {
+	return h.Size() == n || (h == crypto.SHA256 && n == n192)
+}
+
+// pad returns the length of the domain separating prefixes toByte(x, pad) of
+// the keyed hash functions, which is 4 for n = 24 as in NIST SP 800-208, and n
+// otherwise.
+func (o Opts) pad() int {
+	if o.n() == n192 {
+		return 4
+	}
+
+	return o.n()
+}
+
+// tweakableHash returns the tweakable hash function to use for the run of
+// W-OTS+. It panics if Opts.Tweak is not valid.
+func (o Opts) tweakableHash() primitives.TweakableHash {
+	t, err := o.Tweak.tweakableHash()
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return t
+}
+
+// validate checks whether the Mode, Tweak, KeyDerivation, N and Hash of the
+// Opts are supported, and whether the Context is not too long.
+func (o Opts) validate() error {
+	if o.N < 0 || o.N > maxN {
+		return fmt.Errorf("%w: got %d, must be between 1 and %d", ErrInvalidN, o.N, maxN)
+	}
+
+	if _, err := o.Mode.checkedParams(o.n()); err != nil {
+		return err
+	}
+
+	if _, err := o.Tweak.tweakableHash(); err != nil {
+		return err
 	}
 
-	if canPrecompute[o.Hash] {
-		return o.Hash
+	if err := o.KeyDerivation.validate(); err != nil {
+		return err
+	}
+
+	if len(o.Context) > MaxContextBytes {
+		return fmt.Errorf("%w: got %d bytes, at most %d allowed", ErrContextLength, len(o.Context), MaxContextBytes)
+	}
+
+	if _, err := o.checkedHash(); err != nil {
+		return err
+	}
+
+	if o.n() == n192 && o.KeyDerivation != AddressBound {
+		return fmt.Errorf("%w: n = %d requires wotsp.AddressBound, as in NIST SP 800-208", ErrInvalidKeyDerivation, n192)
+	}
+
+	return nil
+}
+
+// binding returns the part of the Opts that a key or signature is bound to:
+// the Mode, Address, N, Tweak, KeyDerivation, XOF and Hash. The default N and
+// Hash are made explicit, so that bindings can be compared using ==.
+func (o Opts) binding() Opts {
+	b := Opts{
+		Mode:          o.Mode,
+		Address:       o.Address,
+		N:             o.n(),
+		Tweak:         o.Tweak,
+		KeyDerivation: o.KeyDerivation,
+		XOF:           o.XOF,
+	}
+
+	if o.XOF == 0 {
+		b.Hash = o.hash()
+	}
+
+	return b
+}
+
+// params returns the primitives.Params for the Mode and N of the Opts. It
+// panics if the Opts are not valid.
+func (o Opts) params() primitives.Params {
+	p := o.Mode.params(o.n())
+	p.Pad = o.pad()
+
+	return p
+}
+
+// sizes returns the expected input and output lengths for the Mode and N of
+// the Opts. The Opts are assumed to be valid.
+func (o Opts) sizes() sizes {
+	return o.Mode.sizes(o.n())
+}
+
+// PublicKeyBytes returns the size in bytes of public keys for the Opts, or 0 if
+// the Opts are not valid.
+func (o Opts) PublicKeyBytes() int {
+	if o.validate() != nil {
+		return 0
+	}
+
+	return o.sizes().publicKey
+}
+
+// SignatureBytes returns the size in bytes of signatures created by Sign for
+// the Opts, or 0 if the Opts are not valid.
+func (o Opts) SignatureBytes() int {
+	if o.validate() != nil {
+		return 0
+	}
+
+	return o.sizes().signature
+}
+
+// MessageSignatureBytes returns the size in bytes of signatures created by
+// SignMessage for the Opts, which include an n-byte randomizer, or 0 if the
+// Opts are not valid.
+func (o Opts) MessageSignatureBytes() int {
+	if o.validate() != nil {
+		return 0
+	}
+
+	return o.n() + o.sizes().signature
+}
+
+// CompressedPublicKeyBytes returns the size in bytes of public keys compressed
+// by CompressPublicKey for the Opts, or 0 if the Opts are not valid.
+func (o Opts) CompressedPublicKeyBytes() int {
+	if o.validate() != nil {
+		return 0
+	}
+
+	return o.n()
+}
+
+// SeedBytes returns the size in bytes of the secret seed for the Opts, or 0 if
+// the Opts are not valid.
+func (o Opts) SeedBytes() int {
+	if o.validate() != nil {
+		return 0
+	}
+
+	return o.sizes().seed
+}
+
+// PubSeedBytes returns the size in bytes of the public seed for the Opts, or 0
+// if the Opts are not valid.
+func (o Opts) PubSeedBytes() int {
+	if o.validate() != nil {
+		return 0
+	}
+
+	return o.sizes().pubSeed
+}
+
+// newHash returns a constructor for the hash function to use for the run of
+// W-OTS+, with an output of n bytes. It panics if the Opts do not select a
+// supported hash function.
+func (o Opts) newHash() func() hash.Hash {
+	if o.XOF != 0 {
+		return o.XOF.newFunc(o.n())
+	}
+
+	return o.hash().New
+}
+
+// newHasher creates the primitives.Hasher for a run of W-OTS+ with the given
+// seeds. The secret seed may be nil. It panics if the Opts are not valid.
+func (o Opts) newHasher(seed, pubSeed []byte) *primitives.Hasher {
+	var h *primitives.Hasher
+	if o.XOF != 0 {
+		h = primitives.NewHasherXOF(primitives.XOF(o.XOF), o.params(), seed, pubSeed, o.routines(), o.tweakableHash())
+	} else {
+		h = primitives.NewHasher(o.hash(), o.params(), seed, pubSeed, o.routines(), o.tweakableHash())
+	}
+	h.SetTracer(o.Tracer)
+	if o.Observer != nil {
+		h.EnableStats()
 	}
 
-	panic(fmt.Sprintf("unsupported value for Opts.Hash [%d]", o.Hash))
+	return h
 }
 
 // routines returns the amount of simultaneous goroutines to use for W-OTS+
@@ -0,0 +1,174 @@
+package wotsp
+
+import (
+	"crypto"
+	"fmt"
+	"math"
+)
+
+// OID identifies a W-OTS+ parameter set, using the numeric identifiers of the
+// WOTS+ parameter sets from RFC 8391, Section 5.2, and NIST SP 800-208.
+type OID uint32
+
+// The OIDs of the parameter sets defined by RFC 8391 and NIST SP 800-208. Not
+// all of them are supported by this implementation, see ParamSets.
+const (
+	OIDSHA2_256     OID = 0x00000001 // WOTSP-SHA2_256
+	OIDSHA2_512     OID = 0x00000002 // WOTSP-SHA2_512
+	OIDSHAKE_256    OID = 0x00000003 // WOTSP-SHAKE_256
+	OIDSHAKE_512    OID = 0x00000004 // WOTSP-SHAKE_512
+	OIDSHA2_192     OID = 0x00000005 // WOTSP-SHA2_192
+	OIDSHAKE256_256 OID = 0x00000006 // WOTSP-SHAKE256_256
+	OIDSHAKE256_192 OID = 0x00000007 // WOTSP-SHAKE256_192
+)
+
+// ParamSet describes a named W-OTS+ parameter set: the combination of a Mode,
+// a hash function and the security parameter n, the length in bytes of the
+// hash outputs, seeds and chain values. The hash function is either Hash or,
+// if set, the extendable-output function XOF. The parameter sets of RFC 8391
+// derive the private key using SeedCounter, those added by NIST SP 800-208
+// using AddressBound.
+type ParamSet struct {
+	Name          string
+	OID           OID
+	Mode          Mode
+	Hash          crypto.Hash
+	XOF           XOF
+	N             int
+	KeyDerivation KeyDerivation
+}
+
+// paramSets holds the parameter sets supported by this implementation.
+var paramSets = []ParamSet{
+	{Name: "WOTSP-SHA2_256", OID: OIDSHA2_256, Mode: W16, Hash: crypto.SHA256, N: 32},
+	{Name: "WOTSP-SHAKE_256", OID: OIDSHAKE_256, Mode: W16, XOF: SHAKE128, N: 32},
+	{Name: "WOTSP-SHAKE256_256", OID: OIDSHAKE256_256, Mode: W16, XOF: SHAKE256, N: 32, KeyDerivation: AddressBound},
+	{Name: "WOTSP-SHA2_512", OID: OIDSHA2_512, Mode: W16, Hash: crypto.SHA512, N: 64},
+	{Name: "WOTSP-SHAKE_512", OID: OIDSHAKE_512, Mode: W16, XOF: SHAKE256, N: 64},
+	{Name: "WOTSP-SHA2_192", OID: OIDSHA2_192, Mode: W16, Hash: crypto.SHA256, N: 24, KeyDerivation: AddressBound},
+	{Name: "WOTSP-SHAKE256_192", OID: OIDSHAKE256_192, Mode: W16, XOF: SHAKE256, N: 24, KeyDerivation: AddressBound},
+}
+
+// ParamSets returns the parameter sets supported by this implementation.
+func ParamSets() []ParamSet {
+	return append([]ParamSet(nil), paramSets...)
+}
+
+// ParamSetByOID returns the parameter set identified by oid. An error wrapping
+// ErrUnknownParamSet is returned if the OID is not known, or if the parameter
+// set it identifies is not supported.
+func ParamSetByOID(oid OID) (ParamSet, error) {
+	for _, p := range paramSets {
+		if p.OID == oid {
+			return p, nil
+		}
+	}
+
+	return ParamSet{}, fmt.Errorf("%w: OID %#08x", ErrUnknownParamSet, uint32(oid))
+}
+
+// ParamSet returns the registered parameter set that matches the Mode, Hash,
+// XOF and N of the Opts. The parameter sets are defined for the Robust
+// tweakable hash function only, so no set matches Opts with another Tweak. The
+// KeyDerivation is not compared, as it does not affect verification. The
+// second return value is false if there is no matching set.
+func (o Opts) ParamSet() (ParamSet, bool) {
+	h, err := o.checkedHash()
+	if err != nil || o.Tweak != Robust {
+		return ParamSet{}, false
+	}
+
+	for _, p := range paramSets {
+		if p.Mode == o.Mode && p.Hash == h && p.XOF == o.XOF && p.N == o.n() {
+			return p, true
+		}
+	}
+
+	return ParamSet{}, false
+}
+
+// Opts returns Opts that select the parameter set. The remaining fields of the
+// returned Opts have their default values.
+func (p ParamSet) Opts() Opts {
+	return Opts{Mode: p.Mode, N: p.N, XOF: p.XOF, Hash: p.Hash, KeyDerivation: p.KeyDerivation}
+}
+
+// String implements fmt.Stringer.
+func (p ParamSet) String() string {
+	return p.Name
+}
+
+// PublicKeyBytes returns the size in bytes of public keys.
+func (p ParamSet) PublicKeyBytes() int {
+	return p.Mode.sizes(p.N).publicKey
+}
+
+// SignatureBytes returns the size in bytes of signatures created by Sign.
+func (p ParamSet) SignatureBytes() int {
+	return p.Mode.sizes(p.N).signature
+}
+
+// SeedBytes returns the size in bytes of the secret seed, which is the secret
+// key.
+func (p ParamSet) SeedBytes() int {
+	return p.Mode.sizes(p.N).seed
+}
+
+// PubSeedBytes returns the size in bytes of the public seed.
+func (p ParamSet) PubSeedBytes() int {
+	return p.Mode.sizes(p.N).pubSeed
+}
+
+// HashCalls holds the number of hash function calls of the W-OTS+ operations.
+// Every chain step costs three calls: two PRF calls for the key and bitmask of
+// the robust tweakable hash, and one call to F. Every element of the private
+// key costs one PRF call. A call may take several calls of the compression
+// function, depending on the hash function; these are reported in
+// Stats.Compressions.
+type HashCalls struct {
+	// GenPublicKey is the exact number of calls made by GenPublicKey.
+	GenPublicKey int
+
+	// Sign and PublicKeyFromSig are the expected number of calls made by Sign
+	// and PublicKeyFromSig respectively, assuming uniformly distributed base-w
+	// digits. Their sum is exactly GenPublicKey.
+	Sign             float64
+	PublicKeyFromSig float64
+}
+
+// HashCalls returns the number of hash function calls of the W-OTS+
+// operations for the parameter set.
+func (p ParamSet) HashCalls() HashCalls {
+	params := p.Opts().params()
+	steps := params.L * int(params.W-1)
+
+	return HashCalls{
+		GenPublicKey:     params.L + 3*steps,
+		Sign:             float64(params.L) + 1.5*float64(steps),
+		PublicKeyFromSig: 1.5 * float64(steps),
+	}
+}
+
+// ClassicalSecurity returns the estimated security level in bits of the
+// parameter set against classical attackers: the bit length 8n of the hash
+// outputs, reduced by the tightness loss log2(w^2 * l + w) of the W-OTS+
+// security proof.
+func (p ParamSet) ClassicalSecurity() int {
+	return 8*p.N - p.securityLoss()
+}
+
+// QuantumSecurity returns the estimated security level in bits of the
+// parameter set against quantum attackers. Grover's algorithm halves the bit
+// length of the hash outputs, which gives 4n reduced by the same tightness
+// loss as ClassicalSecurity.
+func (p ParamSet) QuantumSecurity() int {
+	return 4*p.N - p.securityLoss()
+}
+
+// securityLoss returns log2(w^2 * l + w), rounded up.
+func (p ParamSet) securityLoss() int {
+	params := p.Opts().params()
+	w := float64(params.W)
+
+	return int(math.Ceil(math.Log2(w*w*float64(params.L) + w)))
+}
@@ -0,0 +1,161 @@
+package wotsp
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+func TestParamSetByOID(t *testing.T) {
+	p, err := ParamSetByOID(OIDSHA2_256)
+	noerr(t, err)
+
+	if p.Name != "WOTSP-SHA2_256" || p.Mode != W16 || p.Hash != crypto.SHA256 || p.N != 32 {
+		t.Errorf("wrong parameter set %+v", p)
+	}
+
+	if q, ok := (Opts{}).ParamSet(); !ok || q != p {
+		t.Errorf("default Opts select %+v, expected %+v", q, p)
+	}
+
+	if _, ok := (Opts{Mode: W4}).ParamSet(); ok {
+		t.Error("Opts with Mode W4 select a registered parameter set")
+	}
+
+	if _, ok := (Opts{Tweak: Simple}).ParamSet(); ok {
+		t.Error("Opts with Tweak Simple select a registered parameter set")
+	}
+
+	if q, ok := (Opts{XOF: SHAKE256}).ParamSet(); !ok || q.OID != OIDSHAKE256_256 {
+		t.Errorf("verifier Opts select %+v", q)
+	}
+
+	for _, p := range ParamSets() {
+		if q, ok := p.Opts().ParamSet(); !ok || q != p {
+			t.Errorf("%s: Opts select %+v", p, q)
+		}
+	}
+
+	for _, oid := range []OID{OIDSHA2_192, OIDSHAKE256_256, OIDSHAKE256_192} {
+		p, err := ParamSetByOID(oid)
+		noerr(t, err)
+		if p.KeyDerivation != AddressBound {
+			t.Errorf("%s: wrong key derivation %s", p, p.KeyDerivation)
+		}
+	}
+
+	if _, err := ParamSetByOID(0); !errors.Is(err, ErrUnknownParamSet) {
+		t.Errorf("expected error [%v], got [%v]", ErrUnknownParamSet, err)
+	}
+}
+
+func TestParamSetSizes(t *testing.T) {
+	cases := []struct {
+		mode                Mode
+		publicKey, sigBytes int
+	}{
+		{W4, W4PublicKeyBytes, W4Bytes},
+		{W16, W16PublicKeyBytes, W16Bytes},
+		{W256, W256PublicKeyBytes, W256Bytes},
+	}
+
+	for _, c := range cases {
+		// The sizes do not depend on the availability of the hash function
+		p := ParamSet{Mode: c.mode, Hash: crypto.BLAKE2b_256, N: N}
+
+		if p.PublicKeyBytes() != c.publicKey || p.SignatureBytes() != c.sigBytes {
+			t.Errorf("%s: wrong sizes %d, %d", c.mode, p.PublicKeyBytes(), p.SignatureBytes())
+		}
+		if p.SeedBytes() != N || p.PubSeedBytes() != N {
+			t.Errorf("%s: wrong seed sizes %d, %d", c.mode, p.SeedBytes(), p.PubSeedBytes())
+		}
+	}
+}
+
+func TestParamSetEstimates(t *testing.T) {
+	p, err := ParamSetByOID(OIDSHA2_256)
+	noerr(t, err)
+
+	calls := p.HashCalls()
+	if calls.GenPublicKey != 67+3*67*15 {
+		t.Errorf("wrong hash call count %d for GenPublicKey", calls.GenPublicKey)
+	}
+	if calls.Sign+calls.PublicKeyFromSig != float64(calls.GenPublicKey) {
+		t.Errorf("Sign and PublicKeyFromSig do not add up to GenPublicKey")
+	}
+
+	if p.ClassicalSecurity() != 241 || p.QuantumSecurity() != 113 {
+		t.Errorf("wrong security estimates %d, %d", p.ClassicalSecurity(), p.QuantumSecurity())
+	}
+}
+
+// TestParamSetVectors verifies the registered parameter sets against reference
+// vectors.
+func TestParamSetVectors(t *testing.T) {
+	cases := []struct {
+		oid                OID
+		seed, pubSeed, msg []byte
+		pubKey, sig        []byte
+	}{
+		{OIDSHA2_256, testdata.Seed, testdata.PubSeed, testdata.Message, testdata.PubKey, testdata.Signature},
+		{OIDSHAKE_256, testdata.Seed32, testdata.PubSeed32, testdata.Message32, testdata.PubKeySHAKE_256, testdata.SignatureSHAKE_256},
+		{OIDSHAKE256_256, testdata.Seed32, testdata.PubSeed32, testdata.Message32, testdata.PubKeySHAKE256_256, testdata.SignatureSHAKE256_256},
+		{OIDSHA2_512, testdata.Seed64, testdata.PubSeed64, testdata.Message64, testdata.PubKeySHA2_512, testdata.SignatureSHA2_512},
+		{OIDSHAKE_512, testdata.Seed64, testdata.PubSeed64, testdata.Message64, testdata.PubKeySHAKE_512, testdata.SignatureSHAKE_512},
+		{OIDSHA2_192, testdata.Seed24, testdata.PubSeed24, testdata.Message24, testdata.PubKeySHA2_192, testdata.SignatureSHA2_192},
+		{OIDSHAKE256_192, testdata.Seed24, testdata.PubSeed24, testdata.Message24, testdata.PubKeySHAKE256_192, testdata.SignatureSHAKE256_192},
+	}
+
+	for _, c := range cases {
+		p, err := ParamSetByOID(c.oid)
+		noerr(t, err)
+		opts := p.Opts()
+
+		if len(c.pubKey) != p.PublicKeyBytes() || len(c.seed) != p.SeedBytes() {
+			t.Errorf("%s: wrong sizes", p)
+		}
+
+		if !bytes.Equal(GenPublicKey(c.seed, c.pubSeed, opts), c.pubKey) {
+			t.Errorf("%s: wrong public key", p)
+		}
+		if !bytes.Equal(Sign(c.msg, c.seed, c.pubSeed, opts), c.sig) {
+			t.Errorf("%s: wrong signature", p)
+		}
+		if !Verify(c.pubKey, c.sig, c.msg, c.pubSeed, opts) {
+			t.Errorf("%s: valid signature rejected", p)
+		}
+	}
+}
+
+// TestParamSet192 verifies the sizes of the 192-bit parameter sets of NIST SP
+// 800-208, and that signing arbitrary messages works with truncated digests.
+func TestParamSet192(t *testing.T) {
+	p, err := ParamSetByOID(OIDSHA2_192)
+	noerr(t, err)
+
+	opts := p.Opts()
+	if p.PublicKeyBytes() != 51*24 || p.SignatureBytes() != 51*24 || opts.MessageSignatureBytes() != 52*24 {
+		t.Errorf("wrong sizes %d, %d, %d", p.PublicKeyBytes(), p.SignatureBytes(), opts.MessageSignatureBytes())
+	}
+
+	msg := []byte("a message of arbitrary length")
+	sig, err := SignMessage(nil, msg, testdata.Seed24, testdata.PubSeed24, opts)
+	noerr(t, err)
+
+	if !VerifyMessage(testdata.PubKeySHA2_192, sig, msg, testdata.PubSeed24, opts) {
+		t.Error("Message signature not accepted")
+	}
+
+	_, err = GenPublicKeyChecked(testdata.Seed24, testdata.PubSeed24, Opts{N: 24, Hash: crypto.SHA512})
+	if !errors.Is(err, ErrInvalidN) {
+		t.Errorf("expected error [%v], got [%v]", ErrInvalidN, err)
+	}
+
+	_, err = GenPublicKeyChecked(testdata.Seed24, testdata.PubSeed24, Opts{N: 24})
+	if !errors.Is(err, ErrInvalidKeyDerivation) {
+		t.Errorf("expected error [%v], got [%v]", ErrInvalidKeyDerivation, err)
+	}
+}
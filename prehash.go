@@ -0,0 +1,149 @@
+package wotsp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// PreHash identifies the hash function that was used to compute the digest of
+// a message in pre-hash signing mode.
+//
+// In pre-hash mode, similar to HashSLH-DSA in FIPS 205, the caller signs the
+// digest of a message rather than the message itself. The DER encoded object
+// identifier of the digest algorithm is signed together with the digest:
+//
+//	M' = toByte(1, 1) || toByte(len(ctx), 1) || ctx || OID(PH) || PH(M)
+//
+// so the digest algorithm cannot be substituted by an attacker. The leading
+// domain separator distinguishes pre-hash signatures from the pure signatures
+// created by SignMessage, so a signature in one mode never verifies in the
+// other. Signatures have the same format as those of SignMessage.
+type PreHash int
+
+const (
+	// PreHashSHA256 indicates a SHA-256 digest.
+	PreHashSHA256 PreHash = iota + 1
+
+	// PreHashSHA384 indicates a SHA-384 digest.
+	PreHashSHA384
+
+	// PreHashSHA512 indicates a SHA-512 digest.
+	PreHashSHA512
+
+	// PreHashSHA512_256 indicates a SHA-512/256 digest.
+	PreHashSHA512_256
+
+	// PreHashSHA3_256 indicates a SHA3-256 digest.
+	PreHashSHA3_256
+
+	// PreHashSHA3_512 indicates a SHA3-512 digest.
+	PreHashSHA3_512
+
+	// PreHashSHAKE128 indicates a 256-bit SHAKE128 digest.
+	PreHashSHAKE128
+
+	// PreHashSHAKE256 indicates a 512-bit SHAKE256 digest.
+	PreHashSHAKE256
+)
+
+// preHashInfo describes the digest size and DER encoded object identifier of a
+// PreHash.
+type preHashInfo struct {
+	name string
+	size int
+	oid  []byte
+}
+
+// The object identifiers of the digest algorithms are all of the form
+// 2.16.840.1.101.3.4.2.x, and differ only in the last byte of their encoding.
+var preHashes = map[PreHash]preHashInfo{
+	PreHashSHA256:     {"SHA-256", 32, hashAlgorithmOID(0x01)},
+	PreHashSHA384:     {"SHA-384", 48, hashAlgorithmOID(0x02)},
+	PreHashSHA512:     {"SHA-512", 64, hashAlgorithmOID(0x03)},
+	PreHashSHA512_256: {"SHA-512/256", 32, hashAlgorithmOID(0x06)},
+	PreHashSHA3_256:   {"SHA3-256", 32, hashAlgorithmOID(0x08)},
+	PreHashSHA3_512:   {"SHA3-512", 64, hashAlgorithmOID(0x0a)},
+	PreHashSHAKE128:   {"SHAKE128", 32, hashAlgorithmOID(0x0b)},
+	PreHashSHAKE256:   {"SHAKE256", 64, hashAlgorithmOID(0x0c)},
+}
+
+// hashAlgorithmOID returns the DER encoding of the object identifier
+// 2.16.840.1.101.3.4.2.x.
+func hashAlgorithmOID(x byte) []byte {
+	return []byte{0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, x}
+}
+
+// Size returns the length in bytes of digests produced by the PreHash, or 0 if
+// the PreHash is not known.
+func (ph PreHash) Size() int {
+	return preHashes[ph].size
+}
+
+// OID returns the DER encoded object identifier of the PreHash, or nil if the
+// PreHash is not known.
+func (ph PreHash) OID() []byte {
+	return clone(preHashes[ph].oid)
+}
+
+// String implements fmt.Stringer.
+func (ph PreHash) String() string {
+	if info, ok := preHashes[ph]; ok {
+		return info.name
+	}
+
+	return fmt.Sprintf("<invalid pre-hash %d>", int(ph))
+}
+
+// SignPreHashed signs the digest of a message computed with ph, using the
+// private key generated from seed. The n-byte randomizer is read from rand, or
+// from crypto/rand.Reader if rand is nil. An error is returned if ph is not
+// known, if the digest length does not match ph, or if opts or the seed
+// lengths are invalid.
+func SignPreHashed(rand io.Reader, digest []byte, ph PreHash, seed, pubSeed []byte, opts Opts) ([]byte, error) {
+	msg, err := preHashMessage(digest, ph)
+	if err != nil {
+		return nil, err
+	}
+
+	return signMsg(rand, domainPreHash, msg, seed, pubSeed, opts)
+}
+
+// PublicKeyFromPreHashedSig computes the public key from a signature created
+// by SignPreHashed.
+func PublicKeyFromPreHashedSig(sig, digest []byte, ph PreHash, pubSeed []byte, opts Opts) ([]byte, error) {
+	msg, err := preHashMessage(digest, ph)
+	if err != nil {
+		return nil, err
+	}
+
+	return publicKeyFromMsgSig(sig, domainPreHash, msg, pubSeed, opts)
+}
+
+// VerifyPreHashed checks whether sig is a signature created by SignPreHashed
+// for the public key pk, over the digest computed with ph. Malformed inputs are
+// reported as an invalid signature.
+func VerifyPreHashed(pk, sig, digest []byte, ph PreHash, pubSeed []byte, opts Opts) bool {
+	msg, err := preHashMessage(digest, ph)
+	if err != nil {
+		return false
+	}
+
+	ok, err := verifyMsg(pk, sig, domainPreHash, msg, pubSeed, opts)
+	return err == nil && ok
+}
+
+// preHashMessage returns a reader for OID(ph) || digest, after checking that
+// the digest length matches ph.
+func preHashMessage(digest []byte, ph PreHash) (io.Reader, error) {
+	info, ok := preHashes[ph]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnsupportedPreHash, ph)
+	}
+
+	if err := checkLength(ErrMessageLength, digest, info.size); err != nil {
+		return nil, err
+	}
+
+	return io.MultiReader(bytes.NewReader(info.oid), bytes.NewReader(digest)), nil
+}
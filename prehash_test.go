@@ -0,0 +1,51 @@
+package wotsp
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+// TestSignPreHashed verifies that pre-hash signatures verify only for the same
+// digest algorithm, and are distinguishable from pure signatures.
+func TestSignPreHashed(t *testing.T) {
+	var opts Opts
+	opts.Context = "pre-hash test"
+
+	msg := []byte("a message that is hashed by the caller")
+	digest := sha256.Sum256(msg)
+
+	pubKey := GenPublicKey(testdata.Seed, testdata.PubSeed, opts)
+
+	sig, err := SignPreHashed(nil, digest[:], PreHashSHA256, testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	if !VerifyPreHashed(pubKey, sig, digest[:], PreHashSHA256, testdata.PubSeed, opts) {
+		t.Error("Valid signature rejected")
+	}
+
+	// The same bytes under a different digest algorithm must not verify
+	if VerifyPreHashed(pubKey, sig, digest[:], PreHashSHA3_256, testdata.PubSeed, opts) {
+		t.Error("Signature accepted for a different digest algorithm")
+	}
+
+	// Neither over the digest nor over OID || digest in pure mode
+	if VerifyMessage(pubKey, sig, digest[:], testdata.PubSeed, opts) ||
+		VerifyMessage(pubKey, sig, append(PreHashSHA256.OID(), digest[:]...), testdata.PubSeed, opts) {
+		t.Error("Pre-hash signature accepted as a pure signature")
+	}
+
+	digest512 := sha512.Sum512(msg)
+	_, err = SignPreHashed(nil, digest512[:], PreHashSHA256, testdata.Seed, testdata.PubSeed, opts)
+	if !errors.Is(err, ErrMessageLength) {
+		t.Errorf("expected error [%v], got [%v]", ErrMessageLength, err)
+	}
+
+	_, err = SignPreHashed(nil, digest[:], PreHash(0), testdata.Seed, testdata.PubSeed, opts)
+	if !errors.Is(err, ErrUnsupportedPreHash) {
+		t.Errorf("expected error [%v], got [%v]", ErrUnsupportedPreHash, err)
+	}
+}
@@ -0,0 +1,157 @@
+package primitives
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Address is a structured 32-byte hash address (ADRS) as described in section
+// 2.5 of RFC 8391. It consists of eight 32-bit big-endian words:
+//
+//	word   OTS            L-tree         hash tree
+//	0      layer address  layer address  layer address
+//	1-2    tree address   tree address   tree address
+//	3      type = 0       type = 1       type = 2
+//	4      OTS address    L-tree address padding = 0
+//	5      chain address  tree height    tree height
+//	6      hash address   tree index     tree index
+//	7      keyAndMask     keyAndMask     keyAndMask
+//
+// The FORS address types follow the numbering of SPHINCS+, and use the layout
+// of a hash tree address with word 4 holding the key pair address.
+//
+// An Address converts to and from the [32]byte used by wotsp.Opts.Address
+// with a plain type conversion:
+//
+//	var adrs primitives.Address
+//	adrs.SetOTS(42)
+//	opts.Address = [32]byte(adrs)
+//
+// The setters for type-specific fields do not check whether they apply to the
+// current type of the address, since several fields share the same word.
+type Address [32]byte
+
+// AddressType identifies the type of a hash address, which determines the
+// meaning of words 4 to 6.
+type AddressType uint32
+
+const (
+	// AddressTypeOTS is the type of addresses used within W-OTS+ hash chains.
+	AddressTypeOTS AddressType = iota
+
+	// AddressTypeLTree is the type of addresses used to compress a W-OTS+
+	// public key using an L-tree.
+	AddressTypeLTree
+
+	// AddressTypeHashTree is the type of addresses used within a Merkle tree.
+	AddressTypeHashTree
+
+	// AddressTypeFORSTree is the type of addresses used within a FORS tree.
+	AddressTypeFORSTree
+
+	// AddressTypeFORSRoots is the type of addresses used to compress the roots
+	// of FORS trees.
+	AddressTypeFORSRoots
+)
+
+// String implements fmt.Stringer.
+func (t AddressType) String() string {
+	switch t {
+	case AddressTypeOTS:
+		return "OTS"
+	case AddressTypeLTree:
+		return "L-tree"
+	case AddressTypeHashTree:
+		return "hash tree"
+	case AddressTypeFORSTree:
+		return "FORS tree"
+	case AddressTypeFORSRoots:
+		return "FORS roots"
+	default:
+		return fmt.Sprintf("<unknown address type %d>", uint32(t))
+	}
+}
+
+// word returns the i-th 32-bit word of the address.
+func (a Address) word(i int) uint32 {
+	return binary.BigEndian.Uint32(a[4*i:])
+}
+
+// setWord sets the i-th 32-bit word of the address.
+func (a *Address) setWord(i int, v uint32) {
+	binary.BigEndian.PutUint32(a[4*i:], v)
+}
+
+// Layer returns the layer address.
+func (a Address) Layer() uint32 { return a.word(0) }
+
+// SetLayer sets the layer address.
+func (a *Address) SetLayer(layer uint32) { a.setWord(0, layer) }
+
+// Tree returns the tree address.
+func (a Address) Tree() uint64 { return binary.BigEndian.Uint64(a[4:]) }
+
+// SetTree sets the tree address.
+func (a *Address) SetTree(tree uint64) { binary.BigEndian.PutUint64(a[4:], tree) }
+
+// Type returns the type of the address.
+func (a Address) Type() AddressType { return AddressType(a.word(3)) }
+
+// SetType sets the type of the address, and clears the type-specific words 4
+// to 7 so no fields of the previous type are carried over.
+func (a *Address) SetType(t AddressType) {
+	a.setWord(3, uint32(t))
+	for i := 4; i < 8; i++ {
+		a.setWord(i, 0)
+	}
+}
+
+// OTS returns the OTS address of an OTS address.
+func (a Address) OTS() uint32 { return a.word(4) }
+
+// SetOTS sets the OTS address of an OTS address.
+func (a *Address) SetOTS(ots uint32) { a.setWord(4, ots) }
+
+// Chain returns the chain address of an OTS address.
+func (a Address) Chain() uint32 { return a.word(5) }
+
+// SetChain sets the chain address of an OTS address.
+func (a *Address) SetChain(chain uint32) { a.setWord(5, chain) }
+
+// Hash returns the hash address of an OTS address.
+func (a Address) Hash() uint32 { return a.word(6) }
+
+// SetHash sets the hash address of an OTS address.
+func (a *Address) SetHash(hash uint32) { a.setWord(6, hash) }
+
+// LTree returns the L-tree address of an L-tree address.
+func (a Address) LTree() uint32 { return a.word(4) }
+
+// SetLTree sets the L-tree address of an L-tree address.
+func (a *Address) SetLTree(ltree uint32) { a.setWord(4, ltree) }
+
+// KeyPair returns the key pair address of a FORS address.
+func (a Address) KeyPair() uint32 { return a.word(4) }
+
+// SetKeyPair sets the key pair address of a FORS address.
+func (a *Address) SetKeyPair(keyPair uint32) { a.setWord(4, keyPair) }
+
+// TreeHeight returns the tree height of an L-tree, hash tree or FORS address.
+func (a Address) TreeHeight() uint32 { return a.word(5) }
+
+// SetTreeHeight sets the tree height of an L-tree, hash tree or FORS address.
+func (a *Address) SetTreeHeight(height uint32) { a.setWord(5, height) }
+
+// TreeIndex returns the tree index of an L-tree, hash tree or FORS address.
+func (a Address) TreeIndex() uint32 { return a.word(6) }
+
+// SetTreeIndex sets the tree index of an L-tree, hash tree or FORS address.
+func (a *Address) SetTreeIndex(index uint32) { a.setWord(6, index) }
+
+// KeyAndMask returns the keyAndMask field, which is present in addresses of
+// all types.
+func (a Address) KeyAndMask() uint32 { return a.word(7) }
+
+// SetKeyAndMask sets the keyAndMask field, which is present in addresses of all
+// types.
+func (a *Address) SetKeyAndMask(keyAndMask uint32) { a.setWord(7, keyAndMask) }
@@ -0,0 +1,53 @@
+package primitives
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestAddress verifies the layout of the Address fields against the encoding
+// described in RFC 8391.
+func TestAddress(t *testing.T) {
+	var a Address
+	a.SetLayer(0x01020304)
+	a.SetTree(0x05060708090a0b0c)
+	a.SetType(AddressTypeOTS)
+	a.SetOTS(0x11121314)
+	a.SetChain(0x15161718)
+	a.SetHash(0x191a1b1c)
+	a.SetKeyAndMask(0x1d1e1f20)
+
+	expected := []byte{
+		0x01, 0x02, 0x03, 0x04,
+		0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c,
+		0x00, 0x00, 0x00, 0x00,
+		0x11, 0x12, 0x13, 0x14,
+		0x15, 0x16, 0x17, 0x18,
+		0x19, 0x1a, 0x1b, 0x1c,
+		0x1d, 0x1e, 0x1f, 0x20,
+	}
+	if !bytes.Equal(a[:], expected) {
+		t.Errorf("wrong encoding %x", a[:])
+	}
+
+	if a.Layer() != 0x01020304 || a.Tree() != 0x05060708090a0b0c || a.Type() != AddressTypeOTS ||
+		a.OTS() != 0x11121314 || a.Chain() != 0x15161718 || a.Hash() != 0x191a1b1c ||
+		a.KeyAndMask() != 0x1d1e1f20 {
+		t.Error("wrong field values")
+	}
+
+	a.SetType(AddressTypeLTree)
+	if a.Layer() != 0x01020304 || a.Tree() != 0x05060708090a0b0c {
+		t.Error("SetType modified layer or tree address")
+	}
+	if !bytes.Equal(a[16:], make([]byte, 16)) {
+		t.Error("SetType did not clear type-specific fields")
+	}
+
+	a.SetLTree(3)
+	a.SetTreeHeight(4)
+	a.SetTreeIndex(5)
+	if a.Type() != AddressTypeLTree || a.LTree() != 3 || a.TreeHeight() != 4 || a.TreeIndex() != 5 {
+		t.Error("wrong L-tree field values")
+	}
+}
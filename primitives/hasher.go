@@ -0,0 +1,486 @@
+/*
+Package primitives exposes the building blocks of W-OTS+ as described in RFC
+8391: the keyed hash functions PRF and F, the chaining function, and the base-w
+encoding of messages with their checksum. It is intended for building larger
+structures such as XMSS or SPHINCS trees, or custom protocols, on top of the
+same optimized implementation used by package wotsp.
+
+The functions in this package do not validate their inputs, and panic on
+inputs of the wrong length. Package wotsp should be used for signing and
+verifying with W-OTS+ directly.
+*/
+package primitives
+
+import (
+	"crypto"
+	"encoding/binary"
+	"hash"
+	"reflect"
+	"time"
+)
+
+// Hasher implements the W-OTS+ functions PRF and F efficiently by precomputing
+// part of the hash digests. Using precomputation improves performance by ~41%.
+//
+// Since the PRF function calculates H(toByte(3, n) || seed || M), where seed
+// can be the secret or public seed, the first 2n bytes of the input are
+// recomputed on every evaluation of PRF. We can significantly improve
+// performance by precomputing the hash digest for this part of the input.
+//
+// For F we can only precompute the first n bytes of hash digest: it
+// calculates H(toByte(0, n) || key || M) where key is the result of an
+// evaluation of PRF. For SimpleF the public seed is padded to a full block,
+// so its compression is precomputed entirely.
+//
+// If Params.Pad differs from n, the prefixes toByte(x, n) are shortened to Pad
+// bytes. Digests longer than n bytes are truncated to n bytes.
+//
+// A Hasher holds separate hash function instances for a fixed number of
+// routines, so that chains can be computed concurrently. Each of its methods
+// that takes a routine number may be called concurrently with different
+// routine numbers, but not with the same one.
+type Hasher struct {
+	// Precomputed hash digests
+	precompPrfPubSeed  reflect.Value
+	precompPrfPrivSeed reflect.Value
+	precompPrfKeygen   reflect.Value
+	precompHashF       reflect.Value
+	precompHashH       reflect.Value
+	precompSimple      reflect.Value
+
+	params  Params
+	pad     int
+	pubSeed []byte
+	tweak   TweakableHash
+	tracer  Tracer
+
+	// Hash function instances
+	hashers []hash.Hash
+	// Hash digests of hashers
+	hasherVals []reflect.Value
+
+	// Scratch pads for the keys and bitmasks of each routine
+	scratch []byte
+
+	// Buffers for the full digests of each routine, nil unless the digests
+	// are truncated to n bytes
+	digests [][]byte
+
+	// Counters of each routine, nil unless stats are enabled
+	counters []counters
+	// Construction of the hash function, which determines the number of
+	// compression function calls
+	construction construction
+}
+
+// NewHasher creates a Hasher for the hash function hashFunc, which must have a
+// digest of at least params.N bytes and be available, and the given secret and
+// public seeds. The secret seed may be nil if no operations on it are needed,
+// as is the case when computing a public key from a signature. The Hasher
+// supports routines concurrent routines, which must be at least 1. Chains are
+// computed using the tweakable hash function tweak, or Robust if tweak is nil.
+func NewHasher(hashFunc crypto.Hash, params Params, privSeed, pubSeed []byte, routines int, tweak TweakableHash) *Hasher {
+	h := NewHasherFunc(hashFunc.New, params, privSeed, pubSeed, routines, tweak)
+
+	switch hashFunc {
+	case crypto.BLAKE2s_256, crypto.BLAKE2b_256, crypto.BLAKE2b_384, crypto.BLAKE2b_512:
+		h.construction = blake2
+	}
+
+	return h
+}
+
+// NewHasherXOF is like NewHasher, for the extendable-output function x with
+// an output of params.N bytes. The XOF must be available.
+func NewHasherXOF(x XOF, params Params, privSeed, pubSeed []byte, routines int, tweak TweakableHash) *Hasher {
+	h := NewHasherFunc(func() hash.Hash { return x.New(params.N) }, params, privSeed, pubSeed, routines, tweak)
+	h.construction = sponge
+
+	return h
+}
+
+// NewHasherFunc is like NewHasher, for a hash function that is constructed by
+// newHash rather than registered as a crypto.Hash, such as the XOFs returned
+// by XOF.New. The hash.Hash values returned by newHash must
+// be pointers to structs without internal pointers to their own state, so that
+// their state can be precomputed by copying it. The compression function calls
+// reported by Stats assume the Merkle-Damgård construction of SHA-2.
+func NewHasherFunc(newHash func() hash.Hash, params Params, privSeed, pubSeed []byte, routines int, tweak TweakableHash) *Hasher {
+	if tweak == nil {
+		tweak = Robust{}
+	}
+
+	h := new(Hasher)
+	h.params = params
+	h.pubSeed = pubSeed
+	h.tweak = tweak
+	h.hashers = make([]hash.Hash, routines)
+	h.hasherVals = make([]reflect.Value, routines)
+	h.scratch = make([]byte, routines*h.scratchBytes())
+
+	for i := 0; i < routines; i++ {
+		h.hashers[i] = newHash()
+		h.hasherVals[i] = reflect.ValueOf(h.hashers[i]).Elem()
+	}
+
+	if size := h.hashers[0].Size(); size > params.N {
+		// Digests are truncated to n bytes, as in SHA-256/192
+		h.digests = make([][]byte, routines)
+		for i := range h.digests {
+			h.digests[i] = make([]byte, 0, size)
+		}
+	}
+
+	pad := params.Pad
+	if pad == 0 {
+		pad = params.N
+	}
+	h.pad = pad
+	padding := make([]byte, pad)
+
+	// While padding is all zero, precompute hashF
+	precompHashF := newHash()
+	precompHashF.Write(padding)
+	h.precompHashF = reflect.ValueOf(precompHashF).Elem()
+
+	// Set padding for H and precompute it
+	binary.BigEndian.PutUint16(padding[pad-2:], uint16(1))
+	precompHashH := newHash()
+	precompHashH.Write(padding)
+	h.precompHashH = reflect.ValueOf(precompHashH).Elem()
+
+	// Set padding for prf
+	binary.BigEndian.PutUint16(padding[pad-2:], uint16(3))
+
+	if privSeed != nil {
+		// Precompute prf with private seed (not used in PkFromSig)
+		precompPrfPrivSeed := newHash()
+		precompPrfPrivSeed.Write(padding)
+		precompPrfPrivSeed.Write(privSeed)
+		h.precompPrfPrivSeed = reflect.ValueOf(precompPrfPrivSeed).Elem()
+
+		// Precompute PRF_keygen with private seed
+		binary.BigEndian.PutUint16(padding[pad-2:], uint16(4))
+		precompPrfKeygen := newHash()
+		precompPrfKeygen.Write(padding)
+		precompPrfKeygen.Write(privSeed)
+		h.precompPrfKeygen = reflect.ValueOf(precompPrfKeygen).Elem()
+		binary.BigEndian.PutUint16(padding[pad-2:], uint16(3))
+	}
+
+	// Precompute prf with public seed
+	precompPrfPubSeed := newHash()
+	precompPrfPubSeed.Write(padding)
+	precompPrfPubSeed.Write(pubSeed)
+	h.precompPrfPubSeed = reflect.ValueOf(precompPrfPubSeed).Elem()
+
+	if _, ok := tweak.(Simple); ok {
+		// Precompute the simple tweakable hash with the public seed padded to
+		// a full block, which is done for XOFs as well, unlike in SPHINCS+
+		precompSimple := newHash()
+		if len(pubSeed) > precompSimple.BlockSize() {
+			panic("public seed does not fit in a block of the hash function")
+		}
+		precompSimple.Write(pubSeed)
+		precompSimple.Write(make([]byte, precompSimple.BlockSize()-len(pubSeed)))
+		h.precompSimple = reflect.ValueOf(precompSimple).Elem()
+	}
+
+	return h
+}
+
+// sum writes the digest of the hash function instance of a routine to out,
+// truncated to n bytes.
+func (h *Hasher) sum(routineNr int, out []byte) {
+	if h.digests == nil {
+		h.hashers[routineNr].Sum(out[:0])
+		return
+	}
+
+	digest := h.hashers[routineNr].Sum(h.digests[routineNr][:0])
+	copy(out[:h.params.N], digest)
+}
+
+// Params returns the parameters of the Hasher.
+func (h *Hasher) Params() Params {
+	return h.params
+}
+
+// SetTracer sets the Tracer that receives the intermediate values computed by
+// the Hasher. A nil Tracer disables tracing, which is the default.
+func (h *Hasher) SetTracer(t Tracer) {
+	h.tracer = t
+}
+
+// Routines returns the number of concurrent routines the Hasher supports.
+func (h *Hasher) Routines() int {
+	return len(h.hashers)
+}
+
+// scratchBytes returns the size of the scratch pad of each routine, which
+// holds a key and two n-byte bitmasks.
+func (h *Hasher) scratchBytes() int {
+	return 3 * h.params.N
+}
+
+// scratchPad returns the scratch pad of a routine.
+func (h *Hasher) scratchPad(routineNr int) []byte {
+	size := h.scratchBytes()
+	return h.scratch[routineNr*size : (routineNr+1)*size]
+}
+
+//
+// PRF and F with precomputed hash digests for pub and priv seeds
+//
+
+// F computes the keyed hash function F(key, inout) = H(toByte(0, n) || key ||
+// inout), and writes the n-byte result to inout.
+func (h *Hasher) F(routineNr int, key, inout []byte) {
+	if h.counters != nil {
+		h.counters[routineNr].f++
+		h.counters[routineNr].compressions += h.compressions(h.pad, len(key)+len(inout))
+	}
+
+	h.hasherVals[routineNr].Set(h.precompHashF)
+	h.hashers[routineNr].Write(key)
+	h.hashers[routineNr].Write(inout)
+	h.sum(routineNr, inout)
+}
+
+// H computes the keyed hash function H(key, left || right) = H(toByte(1, n) ||
+// key || left || right), and writes the n-byte result to out.
+func (h *Hasher) H(routineNr int, key, left, right, out []byte) {
+	if h.counters != nil {
+		h.counters[routineNr].h++
+		h.counters[routineNr].compressions += h.compressions(h.pad, len(key)+len(left)+len(right))
+	}
+
+	h.hasherVals[routineNr].Set(h.precompHashH)
+	h.hashers[routineNr].Write(key)
+	h.hashers[routineNr].Write(left)
+	h.hashers[routineNr].Write(right)
+	h.sum(routineNr, out)
+}
+
+// PRF computes PRF(pubSeed, addr) = H(toByte(3, n) || pubSeed || addr), and
+// writes the n-byte result to out.
+func (h *Hasher) PRF(routineNr int, addr *Address, out []byte) {
+	if h.counters != nil {
+		h.counters[routineNr].prf++
+		h.counters[routineNr].compressions += h.compressions(h.pad+len(h.pubSeed), len(addr))
+	}
+
+	h.hasherVals[routineNr].Set(h.precompPrfPubSeed)
+	h.hashers[routineNr].Write(addr[:])
+	h.sum(routineNr, out)
+
+	if h.tracer != nil {
+		h.tracer.PRF(*addr, out[:h.params.N])
+	}
+}
+
+// SimpleF computes H(pubSeed || toByte(0, B-n) || addr || inout), where B is
+// the block size of the hash function, and writes the n-byte result to inout.
+// It is the building block of the Simple tweakable hash function, which the
+// Hasher must have been created with.
+func (h *Hasher) SimpleF(routineNr int, addr *Address, inout []byte) {
+	if h.counters != nil {
+		h.counters[routineNr].f++
+		h.counters[routineNr].compressions += h.compressions(h.hashers[routineNr].BlockSize(), len(addr)+len(inout))
+	}
+
+	h.hasherVals[routineNr].Set(h.precompSimple)
+	h.hashers[routineNr].Write(addr[:])
+	h.hashers[routineNr].Write(inout)
+	h.sum(routineNr, inout)
+}
+
+// SimpleH computes H(pubSeed || toByte(0, B-n) || addr || left || right),
+// where B is the block size of the hash function, and writes the n-byte result
+// to out. It is the building block of the Simple tweakable hash function,
+// which the Hasher must have been created with.
+func (h *Hasher) SimpleH(routineNr int, addr *Address, left, right, out []byte) {
+	if h.counters != nil {
+		h.counters[routineNr].h++
+		h.counters[routineNr].compressions += h.compressions(h.hashers[routineNr].BlockSize(), len(addr)+len(left)+len(right))
+	}
+
+	h.hasherVals[routineNr].Set(h.precompSimple)
+	h.hashers[routineNr].Write(addr[:])
+	h.hashers[routineNr].Write(left)
+	h.hashers[routineNr].Write(right)
+	h.sum(routineNr, out)
+}
+
+// PRFSecret computes PRF(seed, ctr) = H(toByte(3, n) || seed || ctr) for the
+// secret seed, and writes the n-byte result to out. The Hasher must have been
+// created with a secret seed.
+func (h *Hasher) PRFSecret(routineNr int, ctr []byte, out []byte) {
+	if h.counters != nil {
+		h.counters[routineNr].prf++
+		h.counters[routineNr].compressions += h.compressions(h.pad+h.params.N, len(ctr))
+	}
+
+	h.hasherVals[routineNr].Set(h.precompPrfPrivSeed)
+	h.hashers[routineNr].Write(ctr)
+	h.sum(routineNr, out)
+}
+
+// PRFKeygen computes PRF_keygen(seed, pubSeed || addr) = H(toByte(4, n) ||
+// seed || pubSeed || addr) for the secret seed as in NIST SP 800-208, and
+// writes the n-byte result to out. The Hasher must have been created with a
+// secret seed.
+func (h *Hasher) PRFKeygen(routineNr int, addr *Address, out []byte) {
+	if h.counters != nil {
+		h.counters[routineNr].prf++
+		h.counters[routineNr].compressions += h.compressions(h.pad+h.params.N, len(h.pubSeed)+len(addr))
+	}
+
+	h.hasherVals[routineNr].Set(h.precompPrfKeygen)
+	h.hashers[routineNr].Write(h.pubSeed)
+	h.hashers[routineNr].Write(addr[:])
+	h.sum(routineNr, out)
+}
+
+// Chain performs the chaining operation using an n-byte input and n-byte seed.
+// Assumes the input is the <start>-th element in the chain, and performs
+// <steps> iterations of the Hasher's TweakableHash. The chain and OTS address
+// fields of adrs must be set by the caller; the hash and keyAndMask fields are
+// overwritten.
+func (h *Hasher) Chain(routineNr int, in, out []byte, start, steps uint8, adrs *Address) {
+	copy(out, in)
+
+	for i := start; i < start+steps; i++ {
+		adrs.SetHash(uint32(i))
+		h.tweak.F(h, routineNr, adrs, out)
+
+		if h.tracer != nil {
+			h.tracer.ChainNode(*adrs, out[:h.params.N])
+		}
+	}
+}
+
+// ExpandSeed expands the secret seed into an (L*n)-byte private key as in RFC
+// 8391, deriving the i-th element as PRF(seed, toByte(i, 32)). The Hasher must
+// have been created with a secret seed.
+func (h *Hasher) ExpandSeed() []byte {
+	l, n := h.params.L, h.params.N
+
+	privKey := make([]byte, l*n)
+	ctr := make([]byte, 32)
+
+	for i := 0; i < l; i++ {
+		binary.BigEndian.PutUint16(ctr[30:], uint16(i))
+		h.PRFSecret(0, ctr, privKey[i*n:])
+
+		if h.tracer != nil {
+			h.tracer.PrivateKeyElement(i, privKey[i*n:(i+1)*n])
+		}
+	}
+
+	return privKey
+}
+
+// ExpandSeedKeygen expands the secret seed into an (L*n)-byte private key as
+// in NIST SP 800-208 and SPHINCS+: the i-th element is derived using PRFKeygen
+// from the public seed and adrs with chain address i, which binds every
+// element to its position and to the public seed. The hash and keyAndMask
+// fields of adrs are set to 0, its chain field is overwritten. The Hasher must
+// have been created with a secret seed.
+func (h *Hasher) ExpandSeedKeygen(adrs *Address) []byte {
+	l, n := h.params.L, h.params.N
+
+	privKey := make([]byte, l*n)
+	adrs.SetHash(0)
+	adrs.SetKeyAndMask(0)
+
+	for i := 0; i < l; i++ {
+		adrs.SetChain(uint32(i))
+		h.PRFKeygen(0, adrs, privKey[i*n:])
+
+		if h.tracer != nil {
+			h.tracer.PrivateKeyElement(i, privKey[i*n:(i+1)*n])
+		}
+	}
+
+	return privKey
+}
+
+// Wipe clears the precomputed hash digests of the secret seed. The Hasher can
+// no longer be used for operations on the secret seed afterwards.
+func (h *Hasher) Wipe() {
+	for _, v := range []reflect.Value{h.precompPrfPrivSeed, h.precompPrfKeygen} {
+		if v.IsValid() {
+			v.Set(reflect.Zero(v.Type()))
+		}
+	}
+}
+
+// ComputeChains distributes the chains that must be computed between the
+// routines of the Hasher. Chain i is computed from the i-th n-byte block of in
+// to the i-th n-byte block of out, using the chain address i in adrs.
+//
+// When fromSig is true, 'in' contains a signature and 'out' must be a public
+// key; in this case the routines must complete the signature chains so they
+// use lengths as start indices. If fromSig is false, we are either computing a
+// public key from a private key, or a signature from a private key, so the
+// routines use lengths as the amount of iterations to perform.
+func (h *Hasher) ComputeChains(in, out []byte, lengths []uint8, adrs *Address, fromSig bool) {
+	p := h.params
+	numRoutines := h.Routines()
+	chainsPerRoutine := (p.L-1)/numRoutines + 1
+
+	done := make(chan struct{}, numRoutines)
+
+	computeChain := func(nr int, adrs Address) {
+		var began time.Time
+		if h.counters != nil {
+			began = time.Now()
+		}
+
+		firstChain := nr * chainsPerRoutine
+		lastChain := firstChain + chainsPerRoutine - 1
+
+		// Make sure the last routine ends at the right chain
+		if lastChain >= p.L {
+			lastChain = p.L - 1
+		}
+
+		// Compute the hash chains
+		for chainIdx := firstChain; chainIdx <= lastChain; chainIdx++ {
+			adrs.SetChain(uint32(chainIdx))
+
+			input := in[chainIdx*p.N : (chainIdx+1)*p.N]
+			output := out[chainIdx*p.N : (chainIdx+1)*p.N]
+
+			var start, end uint8
+			if fromSig {
+				start = lengths[chainIdx]
+				end = uint8(p.W-1) - lengths[chainIdx]
+			} else {
+				start = 0
+				end = lengths[chainIdx]
+			}
+
+			h.Chain(nr, input, output, start, end, &adrs)
+		}
+
+		if h.counters != nil && firstChain <= lastChain {
+			h.counters[nr].chains += lastChain - firstChain + 1
+			h.counters[nr].duration += time.Since(began)
+		}
+
+		done <- struct{}{}
+	}
+
+	// Start chain computations
+	for routineIdx := 0; routineIdx < numRoutines; routineIdx++ {
+		// adrs is passed by value here to create a new reference
+		go computeChain(routineIdx, *adrs)
+	}
+
+	// Wait for chain computations to complete
+	for i := 0; i < numRoutines; i++ {
+		<-done
+	}
+}
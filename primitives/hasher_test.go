@@ -0,0 +1,193 @@
+package primitives
+
+import (
+	"bytes"
+	"crypto"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+
+	_ "crypto/sha256"
+)
+
+// w16 are the parameters of WOTSP-SHA2_256, which the test data was generated
+// with.
+var w16 = Params{N: N, Pad: N, W: 16, LogW: 4, L1: 64, L2: 3, L: 67}
+
+// TestChains verifies that evaluating the chains one by one yields the public
+// key obtained from the reference implementation of RFC 8391, both from the
+// expanded seed and from the signature.
+func TestChains(t *testing.T) {
+	h := NewHasher(crypto.SHA256, w16, testdata.Seed, testdata.PubSeed, 1, nil)
+
+	privKey := h.ExpandSeed()
+	digits := w16.Digits(testdata.Message)
+
+	var adrs Address
+	pubKey := make([]byte, w16.L*N)
+	for i := 0; i < w16.L; i++ {
+		adrs.SetChain(uint32(i))
+		block := i * N
+
+		// Compute the signature element first, and continue from there
+		sig := make([]byte, N)
+		h.Chain(0, privKey[block:block+N], sig, 0, digits[i], &adrs)
+		if !bytes.Equal(sig, testdata.Signature[block:block+N]) {
+			t.Fatalf("wrong signature element for chain %d", i)
+		}
+
+		h.Chain(0, sig, pubKey[block:block+N], digits[i], uint8(w16.W-1)-digits[i], &adrs)
+	}
+
+	if !bytes.Equal(pubKey, testdata.PubKey) {
+		t.Error("Wrong public key")
+	}
+}
+
+// TestComputeChains verifies that ComputeChains gives the same result for any
+// number of routines.
+func TestComputeChains(t *testing.T) {
+	digits := w16.Digits(testdata.Message)
+
+	for routines := 1; routines <= 8; routines++ {
+		h := NewHasher(crypto.SHA256, w16, nil, testdata.PubSeed, routines, nil)
+
+		var adrs Address
+		pubKey := make([]byte, w16.L*N)
+		h.ComputeChains(testdata.Signature, pubKey, digits, &adrs, true)
+
+		if !bytes.Equal(pubKey, testdata.PubKey) {
+			t.Errorf("Wrong public key with %d routines", routines)
+		}
+	}
+}
+
+// TestDigits verifies the base-w encoding and checksum.
+func TestDigits(t *testing.T) {
+	msg := make([]byte, N)
+	msg[0] = 0xa5
+
+	digits := w16.Digits(msg)
+	if len(digits) != w16.L || digits[0] != 0xa || digits[1] != 0x5 {
+		t.Errorf("wrong message digits %v", digits[:2])
+	}
+
+	// All digits but the first two are zero, so the checksum is
+	// 64*15 - 10 - 5 = 945 = 0x3b1
+	if csum := digits[w16.L1:]; !bytes.Equal(csum, []uint8{0x3, 0xb, 0x1}) {
+		t.Errorf("wrong checksum digits %v", csum)
+	}
+}
+
+// TestDigitsShortMessage verifies that Digits does not pad messages shorter
+// than n bytes, which would make them sign like their zero-padded form.
+func TestDigitsShortMessage(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Digits accepted a short message")
+		}
+	}()
+
+	w16.Digits([]byte("abc"))
+}
+
+// TestNewParams verifies the number of chains computed for every w.
+func TestNewParams(t *testing.T) {
+	cases := []struct {
+		logW   uint
+		l1, l2 int
+	}{
+		{1, 256, 9},
+		{2, 128, 5},
+		{3, 86, 4},
+		{4, 64, 3},
+		{5, 52, 3},
+		{6, 43, 2},
+		{7, 37, 2},
+		{8, 32, 2},
+	}
+
+	for _, c := range cases {
+		p := NewParams(N, c.logW)
+		if p.W != 1<<c.logW || p.L1 != c.l1 || p.L2 != c.l2 || p.L != c.l1+c.l2 {
+			t.Errorf("wrong parameters for w = %d: %+v", 1<<c.logW, p)
+		}
+	}
+
+	if p := NewParams(N, 4); p != w16 {
+		t.Errorf("wrong parameters for w = 16: %+v", p)
+	}
+
+	if p := NewParams(64, 4); p.L1 != 128 || p.L2 != 3 {
+		t.Errorf("wrong parameters for n = 64: %+v", p)
+	}
+}
+
+// TestBaseWUnaligned verifies the base-w encoding for a w whose digits cross
+// byte boundaries, and the zero padding of the last digit.
+func TestBaseWUnaligned(t *testing.T) {
+	w8 := NewParams(N, 3)
+
+	msg := make([]byte, N)
+	msg[0] = 0xa5
+	msg[N-1] = 0x01
+
+	digits := w8.Digits(msg)
+	if !bytes.Equal(digits[:3], []uint8{5, 1, 2}) {
+		t.Errorf("wrong message digits %v", digits[:3])
+	}
+	if digits[w8.L1-1] != 4 {
+		t.Errorf("wrong padded digit %d", digits[w8.L1-1])
+	}
+
+	// The checksum is 86*7 - 5 - 1 - 2 - 4 = 590 = 01 001 001 110 in base 8
+	if csum := digits[w8.L1:]; !bytes.Equal(csum, []uint8{1, 1, 1, 6}) {
+		t.Errorf("wrong checksum digits %v", csum)
+	}
+}
+
+// TestChainSteps verifies for both tweakable hash functions that a chain can
+// be continued from any intermediate position.
+func TestChainSteps(t *testing.T) {
+	for _, tweak := range []TweakableHash{Robust{}, Simple{}} {
+		h := NewHasher(crypto.SHA256, w16, nil, testdata.PubSeed, 1, tweak)
+
+		var adrs Address
+		adrs.SetChain(5)
+
+		full := make([]byte, N)
+		h.Chain(0, testdata.Message, full, 0, 15, &adrs)
+
+		part := make([]byte, N)
+		h.Chain(0, testdata.Message, part, 0, 6, &adrs)
+		h.Chain(0, part, part, 6, 9, &adrs)
+
+		if !bytes.Equal(full, part) {
+			t.Errorf("%T: chain cannot be continued", tweak)
+		}
+	}
+}
+
+// TestLTree verifies the L-tree for three nodes, where the last node is lifted
+// to the second layer.
+func TestLTree(t *testing.T) {
+	h := NewHasher(crypto.SHA256, w16, nil, testdata.PubSeed, 1, nil)
+	pk := testdata.PubKey[:3*N]
+
+	var adrs Address
+	adrs.SetType(AddressTypeLTree)
+	adrs.SetLTree(4)
+
+	root := h.LTree(pk, &adrs)
+
+	expected := make([]byte, N)
+	adrs.SetTreeHeight(0)
+	adrs.SetTreeIndex(0)
+	Robust{}.H(h, 0, &adrs, pk[:N], pk[N:2*N], expected)
+	adrs.SetTreeHeight(1)
+	Robust{}.H(h, 0, &adrs, expected, pk[2*N:], expected)
+
+	if !bytes.Equal(root, expected) {
+		t.Error("Wrong L-tree root")
+	}
+}
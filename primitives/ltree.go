@@ -0,0 +1,36 @@
+package primitives
+
+// LTree compresses a W-OTS+ public key of L*n bytes into a single n-byte node
+// using the L-tree of RFC 8391, section 4.1.5. Pairs of nodes are combined
+// using the H method of the Hasher's TweakableHash until one node remains; an
+// odd node at the end of a layer is lifted to the next layer unchanged.
+//
+// adrs should be an L-tree address; its tree height, tree index and keyAndMask
+// fields are overwritten. pk is not modified. LTree uses the first routine of
+// the Hasher.
+func (h *Hasher) LTree(pk []byte, adrs *Address) []byte {
+	nodes := make([]byte, len(pk))
+	copy(nodes, pk)
+
+	n := h.params.N
+	l := len(nodes) / n
+	adrs.SetTreeHeight(0)
+
+	for l > 1 {
+		for i := 0; i < l/2; i++ {
+			adrs.SetTreeIndex(uint32(i))
+			left := nodes[2*i*n : (2*i+1)*n]
+			right := nodes[(2*i+1)*n : (2*i+2)*n]
+			h.tweak.H(h, 0, adrs, left, right, nodes[i*n:(i+1)*n])
+		}
+
+		if l%2 == 1 {
+			copy(nodes[(l/2)*n:], nodes[(l-1)*n:l*n])
+		}
+
+		l = (l + 1) / 2
+		adrs.SetTreeHeight(adrs.TreeHeight() + 1)
+	}
+
+	return nodes[:n]
+}
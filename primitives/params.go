@@ -0,0 +1,109 @@
+package primitives
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// N is the default security parameter n: the output length of the hash
+// function in bytes, as used by the parameter sets of RFC 8391 based on
+// SHA2-256.
+const N = 32
+
+// Params defines the parameters of a W-OTS+ instance: the security parameter
+// N, which is the length in bytes of messages, seeds and chain values, the
+// Winternitz parameter w = 2^LogW, the number of message chains L1, the number
+// of checksum chains L2 and the total number of chains L = L1 + L2.
+//
+// Pad is the length in bytes of the domain separating prefixes toByte(x, Pad)
+// of the keyed hash functions. It equals N for the parameter sets of RFC 8391,
+// and is 4 for the 192-bit parameter sets of NIST SP 800-208. A Pad of 0 is
+// treated as N.
+type Params struct {
+	N         int
+	Pad       int
+	W         uint
+	LogW      uint
+	L1, L2, L int
+}
+
+// NewParams computes the Params for the security parameter n and the
+// Winternitz parameter w = 2^logW. It panics if n is not positive or if logW is
+// not in the range 1 to 8.
+func NewParams(n int, logW uint) Params {
+	if n <= 0 {
+		panic(fmt.Sprintf("invalid security parameter n = %d", n))
+	}
+	if logW < 1 || logW > 8 {
+		panic(fmt.Sprintf("invalid Winternitz parameter 2^%d", logW))
+	}
+
+	p := Params{N: n, Pad: n, W: 1 << logW, LogW: logW}
+
+	// l1 = ceil(8n / log(w)), l2 = floor(log(l1 * (w - 1)) / log(w)) + 1
+	p.L1 = int((8*uint(n) + logW - 1) / logW)
+	p.L2 = (bits.Len(uint(p.L1)*(p.W-1))-1)/int(logW) + 1
+	p.L = p.L1 + p.L2
+
+	return p
+}
+
+// BaseW computes the base-w representation of a binary input, consisting of
+// outLen digits. The bits of x are consumed from the most significant bit of
+// the first byte onwards, LogW bits per digit. If x contains fewer than
+// outLen*LogW bits, it is padded with zero bits.
+func (p Params) BaseW(x []byte, outLen int) []uint8 {
+	var total uint
+	in := 0
+	bits := uint(0)
+	baseW := make([]uint8, outLen)
+
+	for out := range baseW {
+		for bits < p.LogW {
+			total <<= 8
+			if in < len(x) {
+				total |= uint(x[in])
+				in++
+			}
+			bits += 8
+		}
+
+		bits -= p.LogW
+		baseW[out] = uint8((total >> bits) & (p.W - 1))
+		total &= 1<<bits - 1
+	}
+
+	return baseW
+}
+
+// Checksum computes the L2 base-w digits of the checksum over the L1 base-w
+// digits of a message.
+func (p Params) Checksum(msg []uint8) []uint8 {
+	csum := uint64(0)
+	for i := 0; i < p.L1; i++ {
+		csum += uint64(uint8(p.W-1) - msg[i])
+	}
+	csum <<= 8 - ((uint(p.L2) * p.LogW) % 8)
+
+	// Length of the checksum is (l2*logw + 7) / 8, any higher bits are
+	// truncated as in toByte.
+	csumBytes := make([]byte, (uint(p.L2)*p.LogW+7)/8)
+	for i := len(csumBytes) - 1; i >= 0; i-- {
+		csumBytes[i] = byte(csum)
+		csum >>= 8
+	}
+
+	return p.BaseW(csumBytes, p.L2)
+}
+
+// Digits computes the L base-w digits that determine the chain lengths used to
+// sign msg: the L1 digits of msg, followed by the L2 digits of its checksum.
+// It panics if msg is not p.N bytes long.
+func (p Params) Digits(msg []byte) []uint8 {
+	if len(msg) != p.N {
+		panic(fmt.Sprintf("message of %d bytes, expected n = %d", len(msg), p.N))
+	}
+
+	digits := p.BaseW(msg, p.L1)
+	return append(digits, p.Checksum(digits)...)
+}
@@ -0,0 +1,117 @@
+package primitives
+
+import (
+	"time"
+)
+
+// Stats holds the number of evaluations of the keyed hash functions made by a
+// Hasher, the compression function calls they took, and the work done by each
+// of its routines in ComputeChains. Stats are only collected after
+// EnableStats has been called.
+type Stats struct {
+	// PRF counts the evaluations of PRF, PRFSecret and PRFKeygen.
+	PRF int
+
+	// F counts the evaluations of F and SimpleF.
+	F int
+
+	// H counts the evaluations of H and SimpleH.
+	H int
+
+	// Compressions counts the calls of the compression function made by the
+	// evaluations above, or of the Keccak permutation for an XOF. An
+	// evaluation takes one or more calls, depending on the length of its
+	// input, the block size of the hash function and the precomputed part of
+	// the input. The calls made to precompute digests are not included.
+	Compressions int
+
+	// Routines holds the work done by each routine of the Hasher.
+	Routines []RoutineStats
+}
+
+// RoutineStats holds the work done by a single routine of a Hasher.
+type RoutineStats struct {
+	// Chains is the number of hash chains computed by the routine.
+	Chains int
+
+	// Duration is the wall time the routine spent computing its chains.
+	Duration time.Duration
+}
+
+// counters holds the Stats of a single routine. Each routine only updates its
+// own counters, so they do not need to be synchronized.
+type counters struct {
+	prf, f, h    int
+	compressions int
+	chains       int
+	duration     time.Duration
+}
+
+// EnableStats makes the Hasher count its hash function evaluations and the
+// work done by its routines, which can be obtained using Stats. Counting is
+// disabled by default, so that it does not slow down the computations.
+func (h *Hasher) EnableStats() {
+	if h.counters == nil {
+		h.counters = make([]counters, h.Routines())
+	}
+}
+
+// Stats returns the Stats collected since EnableStats was called. It must not
+// be called concurrently with other methods of the Hasher.
+func (h *Hasher) Stats() Stats {
+	var s Stats
+	if h.counters == nil {
+		return s
+	}
+
+	s.Routines = make([]RoutineStats, len(h.counters))
+	for i, c := range h.counters {
+		s.PRF += c.prf
+		s.F += c.f
+		s.H += c.h
+		s.Compressions += c.compressions
+		s.Routines[i] = RoutineStats{Chains: c.chains, Duration: c.duration}
+	}
+
+	return s
+}
+
+// construction identifies how a hash function processes its input, which
+// determines the number of compression function calls of an evaluation.
+type construction int
+
+const (
+	// merkleDamgard is the construction of SHA-2, which compresses every full
+	// block as soon as it is written, and pads the input with at least one
+	// byte and a length field of an eighth of the block size.
+	merkleDamgard construction = iota
+
+	// blake2 is the construction of BLAKE2, which keeps the last block until
+	// the digest is computed, and needs no padding.
+	blake2
+
+	// sponge is the sponge construction of SHAKE, which permutes the state
+	// every time a full block is absorbed, and pads the input with at least
+	// one byte. Outputs of up to a block are squeezed without further calls.
+	sponge
+)
+
+// compressions returns the number of compression function calls made by an
+// evaluation of the hash function that restores a precomputed state of
+// precomputed input bytes, and appends appended bytes to it.
+func (h *Hasher) compressions(precomputed, appended int) int {
+	b := h.hashers[0].BlockSize()
+	total := precomputed + appended
+
+	switch h.construction {
+	case blake2:
+		if precomputed == 0 {
+			return (total + b - 1) / b
+		}
+		return (total+b-1)/b - (precomputed-1)/b
+	case sponge:
+		return total/b + 1 - precomputed/b
+	default:
+		return (total+1+b/8+b-1)/b - precomputed/b
+	}
+}
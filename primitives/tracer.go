@@ -0,0 +1,25 @@
+package primitives
+
+// Tracer receives the intermediate values computed by a Hasher, so that a
+// full transcript of a W-OTS+ operation can be compared against another
+// implementation. A Tracer is set on a Hasher using SetTracer.
+//
+// The slices passed to a Tracer are only valid for the duration of the call,
+// and must not be modified. When a Hasher is used by multiple routines, the
+// Tracer is called concurrently from each of them.
+type Tracer interface {
+	// PrivateKeyElement is called with the i-th element of the private key,
+	// when the secret seed is expanded by ExpandSeed.
+	PrivateKeyElement(i int, sk []byte)
+
+	// PRF is called with every output of PRF keyed by the public seed,
+	// together with the address it was computed for. For the Robust tweakable
+	// hash function, these are the keys (keyAndMask = 0) and bitmasks
+	// (keyAndMask = 1) of the chain steps.
+	PRF(adrs Address, out []byte)
+
+	// ChainNode is called with every node computed by Chain, together with the
+	// address it was computed with. The hash address of adrs is the position
+	// of the node in the chain minus one.
+	ChainNode(adrs Address, node []byte)
+}
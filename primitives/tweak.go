@@ -0,0 +1,112 @@
+package primitives
+
+// TweakableHash is a tweakable hash function, keyed by the public seed and a
+// hash address. Its F method is used as the chaining function of W-OTS+:
+// Chain calls it once for every step in a hash chain, after setting the hash
+// address of adrs. Its H method compresses two nodes into one, as in the
+// L-tree used by LTree.
+//
+// Implementations must be safe for concurrent use with different routine
+// numbers, and may use the PRF, F, H, SimpleF and SimpleH methods of the
+// Hasher.
+type TweakableHash interface {
+	// F computes the tweakable hash of the n-byte value in inout under the
+	// public seed of h and the address adrs, and writes the result to inout.
+	// It may modify the keyAndMask field of adrs.
+	F(h *Hasher, routineNr int, adrs *Address, inout []byte)
+
+	// H computes the tweakable hash of the n-byte values left and right under
+	// the public seed of h and the address adrs, and writes the n-byte result
+	// to out. It may modify the keyAndMask field of adrs.
+	H(h *Hasher, routineNr int, adrs *Address, left, right, out []byte)
+}
+
+// Robust is the tweakable hash function of RFC 8391, which masks the input with
+// a bitmask and hashes it under a key, both derived from the public seed:
+//
+//	key     = PRF(pubSeed, ADRS with keyAndMask = 0)
+//	bitmask = PRF(pubSeed, ADRS with keyAndMask = 1)
+//	F(pubSeed, ADRS, M) = F(key, M XOR bitmask)
+//
+// Its H method is RAND_HASH, which uses two bitmasks for the two inputs:
+//
+//	bitmask' = PRF(pubSeed, ADRS with keyAndMask = 2)
+//	H(pubSeed, ADRS, L, R) = H(key, (L XOR bitmask) || (R XOR bitmask'))
+//
+// Robust is the default used by NewHasher.
+type Robust struct{}
+
+// F implements TweakableHash.
+func (Robust) F(h *Hasher, routineNr int, adrs *Address, inout []byte) {
+	// The scratch pad of the routine is used as: scratch = key || bitmask.
+	scratch := h.scratchPad(routineNr)
+	n := h.params.N
+
+	adrs.SetKeyAndMask(0)
+	h.PRF(routineNr, adrs, scratch[:n])
+	adrs.SetKeyAndMask(1)
+	h.PRF(routineNr, adrs, scratch[n:])
+
+	for j := 0; j < n; j++ {
+		inout[j] = inout[j] ^ scratch[n+j]
+	}
+
+	h.F(routineNr, scratch[:n], inout)
+}
+
+// H implements TweakableHash.
+func (Robust) H(h *Hasher, routineNr int, adrs *Address, left, right, out []byte) {
+	// The scratch pad of the routine is used as:
+	// 		scratch = key || masked left || masked right.
+	scratch := h.scratchPad(routineNr)
+	n := h.params.N
+
+	adrs.SetKeyAndMask(0)
+	h.PRF(routineNr, adrs, scratch[:n])
+	adrs.SetKeyAndMask(1)
+	h.PRF(routineNr, adrs, scratch[n:2*n])
+	adrs.SetKeyAndMask(2)
+	h.PRF(routineNr, adrs, scratch[2*n:])
+
+	for j := 0; j < n; j++ {
+		scratch[n+j] ^= left[j]
+		scratch[2*n+j] ^= right[j]
+	}
+
+	h.H(routineNr, scratch[:n], scratch[n:2*n], scratch[2*n:], out)
+}
+
+// Simple is modelled after the "simple" tweakable hash function of SPHINCS+,
+// which hashes the input together with the public seed and the address
+// directly, without a bitmask:
+//
+//	F(pubSeed, ADRS, M)    = H(pubSeed || toByte(0, B-n) || ADRS || M)
+//	H(pubSeed, ADRS, L, R) = H(pubSeed || toByte(0, B-n) || ADRS || L || R)
+//
+// where B is the block size of the hash function, so that the compression of
+// the padded public seed can be precomputed. Simple requires a single hash
+// evaluation per chain step instead of three, at the cost of a security
+// argument in the random oracle model. The public seed must fit in a single
+// block of the hash function.
+//
+// Its outputs do not interoperate with SPHINCS+, which differs as follows:
+//
+//   - The public seed is padded to a full block for every hash function. The
+//     SHAKE instances of SPHINCS+ do not pad it, only the SHA-2 instances do.
+//   - ADRS is the 32-byte address of RFC 8391, with its layout. SPHINCS+ uses
+//     its own address layout, which the SHA-2 instances compress to 22 bytes.
+//   - The hash function of the Hasher is used throughout. The SHA-2 instances
+//     of SPHINCS+ use SHA-512 instead of SHA-256 for H at n = 24 and n = 32.
+type Simple struct{}
+
+// F implements TweakableHash.
+func (Simple) F(h *Hasher, routineNr int, adrs *Address, inout []byte) {
+	adrs.SetKeyAndMask(0)
+	h.SimpleF(routineNr, adrs, inout)
+}
+
+// H implements TweakableHash.
+func (Simple) H(h *Hasher, routineNr int, adrs *Address, left, right, out []byte) {
+	adrs.SetKeyAndMask(0)
+	h.SimpleH(routineNr, adrs, left, right, out)
+}
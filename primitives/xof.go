@@ -0,0 +1,52 @@
+package primitives
+
+import (
+	"fmt"
+	"hash"
+)
+
+// XOF identifies an extendable-output function that can be used with a fixed
+// output length as the hash function of a Hasher. Like crypto.Hash, an XOF
+// is only available if an implementation has been linked into the binary
+// using RegisterXOF. Package github.com/lentus/wotsp/shake registers SHAKE128
+// and SHAKE256 when imported.
+type XOF uint
+
+const (
+	// SHAKE128 is the extendable-output function SHAKE128 of FIPS 202.
+	SHAKE128 XOF = 1 + iota
+
+	// SHAKE256 is the extendable-output function SHAKE256 of FIPS 202.
+	SHAKE256
+
+	maxXOF
+)
+
+var xofs = make([]func(size int) hash.Hash, maxXOF)
+
+// RegisterXOF registers a function that returns a new instance of the XOF x
+// with an output of size bytes. It is intended to be called from the init
+// function of packages that implement XOFs. The hash.Hash values returned by
+// newXOF must meet the requirements of NewHasherFunc.
+func RegisterXOF(x XOF, newXOF func(size int) hash.Hash) {
+	if x == 0 || x >= maxXOF {
+		panic(fmt.Sprintf("primitives: RegisterXOF of unknown XOF %d", x))
+	}
+
+	xofs[x] = newXOF
+}
+
+// Available reports whether the XOF is linked into the binary.
+func (x XOF) Available() bool {
+	return x > 0 && x < maxXOF && xofs[x] != nil
+}
+
+// New returns a new hash.Hash computing the XOF with an output of size bytes.
+// It panics if the XOF is not available.
+func (x XOF) New(size int) hash.Hash {
+	if !x.Available() {
+		panic(fmt.Sprintf("primitives: requested XOF %d is unavailable", x))
+	}
+
+	return xofs[x](size)
+}
@@ -0,0 +1,24 @@
+package primitives
+
+import (
+	"crypto/sha256"
+	"hash"
+	"testing"
+)
+
+// TestRegisterXOF verifies that XOFs are only available once registered.
+func TestRegisterXOF(t *testing.T) {
+	if SHAKE128.Available() || XOF(0).Available() || maxXOF.Available() {
+		t.Fatal("XOF available without registration")
+	}
+
+	RegisterXOF(SHAKE128, func(int) hash.Hash { return sha256.New() })
+	defer func() { xofs[SHAKE128] = nil }()
+
+	if !SHAKE128.Available() || SHAKE256.Available() {
+		t.Error("Wrong XOFs available after registration")
+	}
+	if SHAKE128.New(32).Size() != sha256.Size {
+		t.Error("Registered constructor not used")
+	}
+}
@@ -0,0 +1,102 @@
+package wotsp
+
+// ReuseReport describes the secret chain values an attacker learns from two
+// signatures created with the same W-OTS+ key.
+//
+// A signature reveals, for every chain i, the node at position d_i, where d_i
+// is the i-th base-w digit of the message followed by its checksum. Since the
+// chains can be evaluated forward by anyone, every node at a position >= d_i
+// is exposed as well. Two signatures thus expose every node at a position >=
+// min(d1_i, d2_i). A message can be forged if each of its digits is at or
+// above the lowest exposed position of the corresponding chain.
+type ReuseReport struct {
+	// Exposed holds, for each of the L chains, the lowest position in the
+	// chain whose node is exposed by either signature.
+	Exposed []uint8
+
+	// PublicKey is the public key that both signatures were created with.
+	PublicKey []byte
+
+	opts Opts
+}
+
+// AnalyzeKeyReuse analyzes the exposure of the key that created both the
+// signature sig1 of msg1 and the signature sig2 of msg2. The messages must be
+// the n-byte messages passed to Sign. An error wrapping ErrKeyMismatch is
+// returned if the signatures do not belong to the same key, i.e. if the public
+// keys recovered from them differ.
+func AnalyzeKeyReuse(msg1, sig1, msg2, sig2, pubSeed []byte, opts Opts) (*ReuseReport, error) {
+	pk1, err := PublicKeyFromSigChecked(sig1, msg1, pubSeed, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pk2, err := PublicKeyFromSigChecked(sig2, msg2, pubSeed, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if !verifyPublicKey(pk1, pk2) {
+		return nil, ErrKeyMismatch
+	}
+
+	params := opts.params()
+	digits1 := params.Digits(msg1)
+	digits2 := params.Digits(msg2)
+
+	exposed := make([]uint8, params.L)
+	for i := range exposed {
+		exposed[i] = digits1[i]
+		if digits2[i] < exposed[i] {
+			exposed[i] = digits2[i]
+		}
+	}
+
+	return &ReuseReport{Exposed: exposed, PublicKey: pk1, opts: opts}, nil
+}
+
+// Forgeable reports whether a signature of msg, an n-byte message as passed to
+// Sign, can be forged from the exposed chain values. An error is returned if
+// msg has the wrong length.
+func (r *ReuseReport) Forgeable(msg []byte) (bool, error) {
+	missing, err := r.Missing(msg)
+	if err != nil {
+		return false, err
+	}
+
+	return len(missing) == 0, nil
+}
+
+// Missing returns the indices of the chains that prevent forging a signature
+// of msg, an n-byte message as passed to Sign: the chains where the digit of
+// msg lies below the lowest exposed position. An error is returned if msg has
+// the wrong length.
+func (r *ReuseReport) Missing(msg []byte) ([]int, error) {
+	if err := checkLength(ErrMessageLength, msg, r.opts.n()); err != nil {
+		return nil, err
+	}
+
+	digits := r.opts.params().Digits(msg)
+
+	var missing []int
+	for i, d := range digits {
+		if d < r.Exposed[i] {
+			missing = append(missing, i)
+		}
+	}
+
+	return missing, nil
+}
+
+// FullyExposed returns the number of chains whose secret value, at position
+// 0, is exposed.
+func (r *ReuseReport) FullyExposed() int {
+	n := 0
+	for _, e := range r.Exposed {
+		if e == 0 {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,74 @@
+package wotsp
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+// TestAnalyzeKeyReuse verifies the exposure reported for two signatures that
+// together reveal every secret chain value.
+func TestAnalyzeKeyReuse(t *testing.T) {
+	var opts Opts
+
+	// All message digits of msg1 are 0, all checksum digits of msg2 are 0
+	msg1 := make([]byte, N)
+	msg2 := bytes.Repeat([]byte{0xff}, N)
+	sig1 := Sign(msg1, testdata.Seed, testdata.PubSeed, opts)
+	sig2 := Sign(msg2, testdata.Seed, testdata.PubSeed, opts)
+
+	report, err := AnalyzeKeyReuse(msg1, sig1, msg2, sig2, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	if !bytes.Equal(report.PublicKey, testdata.PubKey) {
+		t.Error("Wrong public key")
+	}
+	if report.FullyExposed() != 67 {
+		t.Errorf("%d chains fully exposed, expected 67", report.FullyExposed())
+	}
+
+	forgeable, err := report.Forgeable(testdata.Message)
+	noerr(t, err)
+	if !forgeable {
+		t.Error("Message not forgeable with all secrets exposed")
+	}
+}
+
+// TestAnalyzeKeyReusePartial verifies the chains reported as missing for a
+// partial exposure.
+func TestAnalyzeKeyReusePartial(t *testing.T) {
+	var opts Opts
+
+	msg := testdata.Message
+	report, err := AnalyzeKeyReuse(msg, testdata.Signature, msg, testdata.Signature, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	if !reflect.DeepEqual(report.Exposed, opts.params().Digits(msg)) {
+		t.Error("Exposure does not match the digits of the message")
+	}
+
+	forgeable, err := report.Forgeable(msg)
+	noerr(t, err)
+	if !forgeable {
+		t.Error("Signed message not forgeable")
+	}
+
+	// Lowering the first digit only raises the checksum digits, so the first
+	// chain is the only one missing
+	lower := append([]byte{}, msg...)
+	lower[0] -= 0x10
+	missing, err := report.Missing(lower)
+	noerr(t, err)
+	if !reflect.DeepEqual(missing, []int{0}) {
+		t.Errorf("wrong missing chains %v", missing)
+	}
+
+	other := Sign(msg, testdata.PubSeed, testdata.PubSeed, opts)
+	_, err = AnalyzeKeyReuse(msg, testdata.Signature, msg, other, testdata.PubSeed, opts)
+	if !errors.Is(err, ErrKeyMismatch) {
+		t.Errorf("expected error [%v], got [%v]", ErrKeyMismatch, err)
+	}
+}
@@ -0,0 +1,82 @@
+//go:build go1.24
+// +build go1.24
+
+/*
+Package shake registers the extendable-output functions SHAKE128 and SHAKE256,
+so that they can be selected as the hash function of W-OTS+ using
+wotsp.Opts.XOF or primitives.XOF. It is imported for its side effect only:
+
+	import _ "github.com/lentus/wotsp/shake"
+
+The package uses crypto/sha3, and thus requires Go 1.24 or newer. The other
+packages of this module do not depend on it.
+*/
+package shake
+
+import (
+	"crypto/sha3"
+	"hash"
+
+	"github.com/lentus/wotsp/primitives"
+)
+
+func init() {
+	primitives.RegisterXOF(primitives.SHAKE128, newSHAKE128)
+	primitives.RegisterXOF(primitives.SHAKE256, newSHAKE256)
+}
+
+// xof adapts a SHAKE extendable-output function to hash.Hash, with a fixed
+// output length. As its state is a plain value, it can be precomputed by a
+// Hasher like the state of the fixed-length hash functions.
+type xof struct {
+	shake sha3.SHAKE
+	size  int
+}
+
+// newSHAKE128 returns a hash.Hash that computes SHAKE128 with an output of
+// size bytes.
+func newSHAKE128(size int) hash.Hash {
+	return &xof{shake: *sha3.NewSHAKE128(), size: size}
+}
+
+// newSHAKE256 returns a hash.Hash that computes SHAKE256 with an output of
+// size bytes.
+func newSHAKE256(size int) hash.Hash {
+	return &xof{shake: *sha3.NewSHAKE256(), size: size}
+}
+
+// Write implements hash.Hash.
+func (x *xof) Write(p []byte) (int, error) {
+	return x.shake.Write(p)
+}
+
+// Sum implements hash.Hash. It appends the output to b without changing the
+// underlying state.
+func (x *xof) Sum(b []byte) []byte {
+	shake := x.shake
+
+	out := b
+	if cap(out)-len(out) >= x.size {
+		out = out[:len(out)+x.size]
+	} else {
+		out = append(out, make([]byte, x.size)...)
+	}
+	shake.Read(out[len(b):])
+
+	return out
+}
+
+// Reset implements hash.Hash.
+func (x *xof) Reset() {
+	x.shake.Reset()
+}
+
+// Size implements hash.Hash.
+func (x *xof) Size() int {
+	return x.size
+}
+
+// BlockSize implements hash.Hash.
+func (x *xof) BlockSize() int {
+	return x.shake.BlockSize()
+}
@@ -0,0 +1,33 @@
+//go:build go1.24
+// +build go1.24
+
+package shake
+
+import (
+	"bytes"
+	"crypto/sha3"
+	"testing"
+
+	"github.com/lentus/wotsp/primitives"
+)
+
+// TestSHAKE verifies that the SHAKE adapters compute the expected output, and
+// that Sum does not change their state.
+func TestSHAKE(t *testing.T) {
+	h := primitives.SHAKE256.New(64)
+	h.Write([]byte("abc"))
+
+	first := h.Sum([]byte{0xff})
+	if first[0] != 0xff || !bytes.Equal(first[1:], sha3.SumSHAKE256([]byte("abc"), 64)) {
+		t.Error("Wrong SHAKE256 output")
+	}
+
+	h.Write([]byte("def"))
+	if !bytes.Equal(h.Sum(nil), sha3.SumSHAKE256([]byte("abcdef"), 64)) {
+		t.Error("Sum changed the SHAKE256 state")
+	}
+
+	if h.Size() != 64 || h.BlockSize() != 136 || primitives.SHAKE128.New(32).BlockSize() != 168 {
+		t.Error("Wrong sizes")
+	}
+}
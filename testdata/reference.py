@@ -0,0 +1,148 @@
+"""Independent reference implementation of W-OTS+ (RFC 8391), used to generate
+the test vectors in this package. It deliberately shares no code with the Go
+implementation, and reproduces the vectors of the C reference implementation
+in testdata.go.
+
+Usage: python3 reference.py <set> > <set>.go"""
+import hashlib
+import sys
+
+
+def to_byte(x, n):
+    return x.to_bytes(n, 'big')
+
+
+class Params:
+    def __init__(self, h, n, w=16, pad=None, keygen=False):
+        self.h, self.n, self.w = h, n, w
+        self.keygen = keygen
+        self.pad = n if pad is None else pad
+        self.logw = w.bit_length() - 1
+        self.l1 = -(-8 * n // self.logw)
+        x = self.l1 * (w - 1)
+        self.l2 = (x.bit_length() - 1) // self.logw + 1
+        self.l = self.l1 + self.l2
+
+    def H(self, data):
+        return self.h(data)[:self.n]
+
+
+def sha256(d): return hashlib.sha256(d).digest()
+def sha512(d): return hashlib.sha512(d).digest()
+def shake128(n): return lambda d: hashlib.shake_128(d).digest(n)
+def shake256(n): return lambda d: hashlib.shake_256(d).digest(n)
+
+
+def adrs_bytes(words):
+    return b''.join(to_byte(w, 4) for w in words)
+
+
+def prf(p, key, m):
+    return p.H(to_byte(3, p.pad) + key + m)
+
+
+def f(p, key, m):
+    return p.H(to_byte(0, p.pad) + key + m)
+
+
+def chain(p, x, start, steps, pub_seed, adrs):
+    for i in range(start, start + steps):
+        adrs[6] = i
+        adrs[7] = 0
+        key = prf(p, pub_seed, adrs_bytes(adrs))
+        adrs[7] = 1
+        bm = prf(p, pub_seed, adrs_bytes(adrs))
+        x = f(p, key, bytes(a ^ b for a, b in zip(x, bm)))
+    return x
+
+
+def base_w(p, x, out_len):
+    bits = ''.join(format(b, '08b') for b in x)
+    bits += '0' * max(0, out_len * p.logw - len(bits))
+    return [int(bits[i * p.logw:(i + 1) * p.logw], 2) for i in range(out_len)]
+
+
+def digits(p, msg):
+    d = base_w(p, msg, p.l1)
+    csum = sum(p.w - 1 - x for x in d)
+    csum <<= 8 - ((p.l2 * p.logw) % 8)
+    nbytes = (p.l2 * p.logw + 7) // 8
+    csum &= (1 << (8 * nbytes)) - 1
+    return d + base_w(p, to_byte(csum, nbytes), p.l2)
+
+
+def prf_keygen(p, key, m):
+    return p.H(to_byte(4, p.pad) + key + m)
+
+
+def sk(p, seed, pub_seed):
+    if p.keygen:
+        # NIST SP 800-208: PRF_keygen(seed, pubSeed || ADRS)
+        return [prf_keygen(p, seed, pub_seed + adrs_bytes([0, 0, 0, 0, 0, i, 0, 0]))
+                for i in range(p.l)]
+    return [prf(p, seed, to_byte(i, 32)) for i in range(p.l)]
+
+
+def gen_pk(p, seed, pub_seed):
+    return b''.join(chain(p, s, 0, p.w - 1, pub_seed, [0, 0, 0, 0, 0, i, 0, 0])
+                    for i, s in enumerate(sk(p, seed, pub_seed)))
+
+
+def sign(p, msg, seed, pub_seed):
+    return b''.join(chain(p, s, 0, d, pub_seed, [0, 0, 0, 0, 0, i, 0, 0])
+                    for i, (s, d) in enumerate(zip(sk(p, seed, pub_seed), digits(p, msg))))
+
+
+def go_bytes(name, data, comment=None, per_line=16):
+    out = []
+    if comment:
+        out.append(comment)
+    out.append('var %s = []byte{' % name)
+    for i in range(0, len(data), per_line):
+        out.append('\t' + ' '.join('0x%02x,' % b for b in data[i:i + per_line]))
+    out.append('}')
+    return '\n'.join(out) + '\n'
+
+
+def shake_bytes(label, n):
+    return hashlib.shake_256(label.encode()).digest(n)
+
+
+# The vector sets, mapping the name of a set to its security parameter n and
+# its parameter sets, given by name and Params.
+VECTOR_SETS = {
+    'n32': (32, [
+        ('SHAKE_256', Params(shake128(32), 32)),
+        ('SHAKE256_256', Params(shake256(32), 32, keygen=True)),
+    ]),
+    'n64': (64, [
+        ('SHA2_512', Params(sha512, 64)),
+        ('SHAKE_512', Params(shake256(64), 64)),
+    ]),
+    'n24': (24, [
+        ('SHA2_192', Params(sha256, 24, pad=4, keygen=True)),
+        ('SHAKE256_192', Params(shake256(24), 24, pad=4, keygen=True)),
+    ]),
+}
+
+
+def main(name):
+    n, sets = VECTOR_SETS[name]
+    seed = shake_bytes('wotsp seed %d' % n, n)
+    pub_seed = shake_bytes('wotsp public seed %d' % n, n)
+    msg = shake_bytes('wotsp message %d' % n, n)
+
+    out = ['// Code generated by reference.py %s; DO NOT EDIT.\n' % name,
+           'package testdata\n']
+    out.append(go_bytes('Seed%d' % n, seed))
+    out.append(go_bytes('PubSeed%d' % n, pub_seed))
+    out.append(go_bytes('Message%d' % n, msg))
+    for set_name, p in sets:
+        out.append(go_bytes('PubKey' + set_name, gen_pk(p, seed, pub_seed)))
+        out.append(go_bytes('Signature' + set_name, sign(p, msg, seed, pub_seed)))
+
+    sys.stdout.write('\n'.join(out))
+
+
+if __name__ == '__main__':
+    main(sys.argv[1])
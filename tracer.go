@@ -0,0 +1,9 @@
+package wotsp
+
+import (
+	"github.com/lentus/wotsp/primitives"
+)
+
+// Tracer receives the intermediate values of W-OTS+ operations when set as
+// Opts.Tracer. See primitives.Tracer for details.
+type Tracer = primitives.Tracer
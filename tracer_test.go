@@ -0,0 +1,69 @@
+package wotsp
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+// recorder is a Tracer that records a transcript of all traced values.
+type recorder struct {
+	mu       sync.Mutex
+	privKey  [][]byte
+	prfs     []Address
+	nodes    map[uint32][]byte // last node of every chain
+	numNodes int
+}
+
+func (r *recorder) PrivateKeyElement(i int, sk []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.privKey = append(r.privKey, append([]byte{}, sk...))
+}
+
+func (r *recorder) PRF(adrs Address, out []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.prfs = append(r.prfs, adrs)
+}
+
+func (r *recorder) ChainNode(adrs Address, node []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.nodes == nil {
+		r.nodes = make(map[uint32][]byte)
+	}
+	r.nodes[adrs.Chain()] = append([]byte{}, node...)
+	r.numNodes++
+}
+
+// TestTracer verifies that a Tracer receives every intermediate value of a
+// public key generation.
+func TestTracer(t *testing.T) {
+	rec := new(recorder)
+
+	var opts Opts
+	opts.Mode = W16
+	opts.Concurrency = 4
+	opts.Tracer = rec
+
+	pubKey := GenPublicKey(testdata.Seed, testdata.PubSeed, opts)
+
+	if len(rec.privKey) != 67 {
+		t.Errorf("traced %d private key elements, expected 67", len(rec.privKey))
+	}
+	if rec.numNodes != 67*15 {
+		t.Errorf("traced %d chain nodes, expected %d", rec.numNodes, 67*15)
+	}
+	if len(rec.prfs) != 2*67*15 {
+		t.Errorf("traced %d PRF outputs, expected %d", len(rec.prfs), 2*67*15)
+	}
+
+	for i := 0; i < 67; i++ {
+		if !bytes.Equal(rec.nodes[uint32(i)], pubKey[i*N:(i+1)*N]) {
+			t.Fatalf("last node of chain %d is not the public key element", i)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package wotsp
+
+import (
+	"fmt"
+
+	"github.com/lentus/wotsp/primitives"
+)
+
+// Tweak selects the construction of the tweakable hash function that is used
+// as the chaining function. The default, which is used when no explicit Tweak
+// is chosen, is Robust.
+//
+// Signatures and public keys created with one construction are incompatible
+// with the other. See primitives.TweakableHash for details.
+type Tweak int
+
+const (
+	// Robust selects the tweakable hash function of RFC 8391, which derives a
+	// key and a bitmask for every chain step. Robust is the default.
+	Robust Tweak = iota
+
+	// Simple selects a variant of the "simple" tweakable hash function of
+	// SPHINCS+, which requires a single hash evaluation per chain step,
+	// without bitmasks. It does not interoperate with SPHINCS+, see
+	// primitives.Simple for the differences.
+	Simple
+)
+
+// tweakableHash returns the primitives.TweakableHash for the Tweak, or an error
+// if the Tweak is not valid.
+func (t Tweak) tweakableHash() (primitives.TweakableHash, error) {
+	switch t {
+	case Robust:
+		return primitives.Robust{}, nil
+	case Simple:
+		return primitives.Simple{}, nil
+	default:
+		return nil, fmt.Errorf("%w %s, must be either wotsp.Robust or wotsp.Simple", ErrInvalidTweak, t)
+	}
+}
+
+// String implements fmt.Stringer.
+func (t Tweak) String() string {
+	switch t {
+	case Robust:
+		return "Robust"
+	case Simple:
+		return "Simple"
+	default:
+		return fmt.Sprintf("<invalid tweak %d>", t)
+	}
+}
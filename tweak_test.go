@@ -0,0 +1,101 @@
+package wotsp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lentus/wotsp/testdata"
+)
+
+// TestTweakSimple verifies the simple tweakable hash function for all
+// parameter sets, and that its signatures are incompatible with Robust.
+func TestTweakSimple(t *testing.T) {
+	for _, mode := range []Mode{W4, W16, W256} {
+		t.Run(fmt.Sprintf("Simple-%s", mode), func(t *testing.T) {
+			var opts Opts
+			opts.Mode = mode
+			opts.Tweak = Simple
+
+			pubKey := GenPublicKey(testdata.Seed, testdata.PubSeed, opts)
+			sig := Sign(testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+
+			if !Verify(pubKey, sig, testdata.Message, testdata.PubSeed, opts) {
+				t.Error("Valid signature rejected")
+			}
+
+			opts.Tweak = Robust
+			if Verify(pubKey, sig, testdata.Message, testdata.PubSeed, opts) {
+				t.Error("Signature accepted with a different tweakable hash")
+			}
+		})
+	}
+}
+
+// TestTweakSimpleChain verifies a chain computed with the simple tweakable
+// hash function against a direct computation of
+// H(pubSeed || toByte(0, B-n) || ADRS || M).
+func TestTweakSimpleChain(t *testing.T) {
+	rec := new(recorder)
+
+	var opts Opts
+	opts.Tweak = Simple
+	opts.Tracer = rec
+
+	pubKey := GenPublicKey(testdata.Seed, testdata.PubSeed, opts)
+	if len(rec.privKey) != 67 {
+		t.Fatalf("%d private key elements traced, expected 67", len(rec.privKey))
+	}
+
+	node := rec.privKey[0]
+	for i := 0; i < 15; i++ {
+		adrs := Address(opts.Address)
+		adrs.SetChain(0)
+		adrs.SetHash(uint32(i))
+
+		h := sha256.New()
+		h.Write(testdata.PubSeed)
+		h.Write(make([]byte, h.BlockSize()-N))
+		h.Write(adrs[:])
+		h.Write(node)
+		node = h.Sum(nil)
+	}
+
+	if !bytes.Equal(node, pubKey[:N]) {
+		t.Error("Wrong public key element 0")
+	}
+}
+
+// TestTweakInvalid verifies that an invalid Tweak is rejected, and that the
+// Tweak is part of the binding of keys.
+func TestTweakInvalid(t *testing.T) {
+	var opts Opts
+	opts.Tweak = Tweak(7)
+
+	_, err := SignChecked(testdata.Message, testdata.Seed, testdata.PubSeed, opts)
+	if !errors.Is(err, ErrInvalidTweak) {
+		t.Errorf("expected error [%v], got [%v]", ErrInvalidTweak, err)
+	}
+
+	opts.Tweak = Simple
+	priv, err := NewPrivateKey(testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+
+	data, err := priv.MarshalBinary()
+	noerr(t, err)
+
+	decoded := new(PrivateKey)
+	noerr(t, decoded.UnmarshalBinary(data))
+	if decoded.Opts().Tweak != Simple {
+		t.Error("Tweak not encoded")
+	}
+
+	opts.Tweak = Robust
+	robust, err := NewPrivateKey(testdata.Seed, testdata.PubSeed, opts)
+	noerr(t, err)
+	if robust.Equal(decoded) {
+		t.Error("Keys with different tweakable hashes are equal")
+	}
+}
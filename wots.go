@@ -1,7 +1,8 @@
 /*
 
 Package wotsp implements WOTSP-SHA2_256 as documented in RFC 8391
-(https://datatracker.ietf.org/doc/rfc8391/).
+(https://datatracker.ietf.org/doc/rfc8391/), as well as the other supported
+parameter sets of RFC 8391 and NIST SP 800-208 listed by ParamSets.
 
 W-OTS+ is a one-time hash-based signature scheme that is most commonly used in
 a larger scheme such as XMSS or SPHINCS. As a W-OTS+ private key/private seed
@@ -11,76 +12,124 @@ in larger structures such as SPHINCS.
 
 Since SHA512_256, BLAKE2b_256 and BLAKE2s_256 work out of the box, they can be
 used as the internal hash function as well by setting Opts.Hash to their
-corresponding crypto.Hash values.
+corresponding crypto.Hash values. The extendable-output functions SHAKE128 and
+SHAKE256 can be selected using Opts.XOF, after importing package
+github.com/lentus/wotsp/shake to link them into the binary.
+
+The security parameter n, the length in bytes of hash outputs, is 32 by
+default and can be changed using Opts.N together with a hash function of the
+matching digest size.
+
+Sign, PublicKeyFromSig and Verify operate on messages of exactly n bytes. To
+sign messages of arbitrary length, use SignMessage and VerifyMessage, which
+first apply the randomized message hash H_msg from RFC 8391.
+
+The building blocks of W-OTS+, such as the chaining function and the base-w
+encoding, are available in package primitives for use in larger structures.
 
 */
 package wotsp
 
 import (
 	"crypto/subtle"
+
+	"github.com/lentus/wotsp/primitives"
 )
 
-// N is a constant defined as the output length of the used hash function.
-const N = 32
+// N is the default security parameter n, the output length of the used hash
+// function. See Opts.N.
+const N = primitives.N
 
 // GenPublicKey computes the public key that corresponds to the expanded seed.
 func GenPublicKey(seed, pubSeed []byte, opts Opts) (pubKey []byte) {
-	params := opts.Mode.params()
+	params := opts.params()
 
-	numRoutines := opts.routines()
-	h := newHasher(seed, pubSeed, opts, numRoutines)
+	h := opts.newHasher(seed, pubSeed)
 
-	privKey := h.expandSeed()
+	privKey := opts.KeyDerivation.expandSeed(h, opts)
 
 	// Initialise list of chain lengths for full chains
-	lengths := make([]uint8, params.l)
+	lengths := make([]uint8, params.L)
 	for i := range lengths {
-		lengths[i] = uint8(params.w - 1)
+		lengths[i] = uint8(params.W - 1)
 	}
 
-	adrs := &opts.Address
-	pubKey = make([]byte, params.l*N)
-	h.computeChains(numRoutines, privKey, pubKey, lengths, adrs, params, false)
+	adrs := (*Address)(&opts.Address)
+	pubKey = make([]byte, params.L*params.N)
+	h.ComputeChains(privKey, pubKey, lengths, adrs, false)
+
+	// Do not leave secret values lying around in memory
+	wipe(privKey)
+	h.Wipe()
+
+	opts.observe(OperationGenPublicKey, h)
 
 	return
 }
 
 // Sign generates the signature of msg using the private key generated using the
 // given seed.
+//
+// If opts.VerifyAfterSign is set and the signature fails verification, Sign
+// returns nil rather than the faulty signature. Use SignChecked to have
+// ErrFaultDetected returned instead.
 func Sign(msg, seed, pubSeed []byte, opts Opts) (sig []byte) {
-	params := opts.Mode.params()
+	sig, _ = sign(msg, seed, pubSeed, opts)
+	return sig
+}
 
-	numRoutines := opts.routines()
-	h := newHasher(seed, pubSeed, opts, numRoutines)
+// sign implements Sign, and returns ErrFaultDetected if opts.VerifyAfterSign
+// is set and the signature fails verification.
+func sign(msg, seed, pubSeed []byte, opts Opts) (sig []byte, err error) {
+	params := opts.params()
 
-	privKey := h.expandSeed()
-	lengths := h.baseW(msg, params.l1)
+	h := opts.newHasher(seed, pubSeed)
 
-	csum := h.checksum(lengths)
-	lengths = append(lengths, csum...)
+	privKey := opts.KeyDerivation.expandSeed(h, opts)
+	lengths := params.Digits(msg)
 
-	adrs := &opts.Address
-	sig = make([]byte, params.l*N)
-	h.computeChains(numRoutines, privKey, sig, lengths, adrs, params, false)
+	adrs := (*Address)(&opts.Address)
+	sig = make([]byte, params.L*params.N)
+	h.ComputeChains(privKey, sig, lengths, adrs, false)
 
-	return
+	// Do not leave secret values lying around in memory
+	wipe(privKey)
+	h.Wipe()
+
+	opts.observe(OperationSign, h)
+
+	if opts.VerifyAfterSign && !verifyAfterSign(sig, msg, seed, pubSeed, opts) {
+		wipe(sig)
+		return nil, ErrFaultDetected
+	}
+
+	return sig, nil
+}
+
+// verifyAfterSign recomputes the public key from the private key and from a
+// fresh signature independently, and reports whether they are equal. As a
+// fault during the computation of sig is unlikely to be repeated in both
+// computations, this detects faulty signatures before they are released.
+func verifyAfterSign(sig, msg, seed, pubSeed []byte, opts Opts) bool {
+	pubKey := GenPublicKey(seed, pubSeed, opts)
+	pubKeyFromSig := PublicKeyFromSig(sig, msg, pubSeed, opts)
+
+	return verifyPublicKey(pubKey, pubKeyFromSig)
 }
 
 // PublicKeyFromSig generates a public key from the given signature
 func PublicKeyFromSig(sig, msg, pubSeed []byte, opts Opts) (pubKey []byte) {
-	params := opts.Mode.params()
+	params := opts.params()
 
-	numRoutines := opts.routines()
-	h := newHasher(nil, pubSeed, opts, numRoutines)
+	h := opts.newHasher(nil, pubSeed)
 
-	lengths := h.baseW(msg, h.params.l1)
+	lengths := params.Digits(msg)
 
-	csum := h.checksum(lengths)
-	lengths = append(lengths, csum...)
+	adrs := (*Address)(&opts.Address)
+	pubKey = make([]byte, params.L*params.N)
+	h.ComputeChains(sig, pubKey, lengths, adrs, true)
 
-	adrs := &opts.Address
-	pubKey = make([]byte, params.l*N)
-	h.computeChains(numRoutines, sig, pubKey, lengths, adrs, params, true)
+	opts.observe(OperationPublicKeyFromSig, h)
 
 	return
 }
@@ -89,7 +138,19 @@ func PublicKeyFromSig(sig, msg, pubSeed []byte, opts Opts) (pubKey []byte) {
 func Verify(pk, sig, msg, pubSeed []byte, opts Opts) bool {
 	pubKeyFromSig := PublicKeyFromSig(sig, msg, pubSeed, opts)
 
+	return verifyPublicKey(pk, pubKeyFromSig)
+}
+
+// verifyPublicKey compares a public key to one computed from a signature.
+func verifyPublicKey(pk, pubKeyFromSig []byte) bool {
 	// use subtle.ConstantTimeCompare instead of bytes.Equal to avoid timing
 	// attacks.
 	return subtle.ConstantTimeCompare(pk, pubKeyFromSig) == 1
 }
+
+// wipe overwrites b with zeroes.
+func wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
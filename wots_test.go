@@ -2,7 +2,9 @@ package wotsp
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -12,6 +14,7 @@ import (
 	// library itself, to avoid including more packages than the library's user
 	// will actually need.
 	_ "crypto/sha256"
+	_ "crypto/sha512"
 )
 
 // noerr is a helper that triggers t.Fatal[f] if the error is non-nil.
@@ -77,7 +80,7 @@ func TestVerify(t *testing.T) {
 // sets by generating a public key and a signature, and verifying the signature
 // for that public key.
 func TestAll(t *testing.T) {
-	for _, mode := range []Mode{W4, W16, W256} {
+	for _, mode := range []Mode{W2, W4, W8, W16, W32, W64, W128, W256} {
 		var opts Opts
 		opts.Mode = mode
 
@@ -107,6 +110,62 @@ func TestAll(t *testing.T) {
 	}
 }
 
+// TestSecurityParameter verifies signing and verifying, as well as the
+// encoding of keys, for a security parameter n other than the default.
+func TestSecurityParameter(t *testing.T) {
+	opts := Opts{N: 64, Hash: crypto.SHA512}
+
+	if opts.PublicKeyBytes() != 131*64 || opts.SeedBytes() != 64 || opts.MessageSignatureBytes() != 132*64 {
+		t.Errorf("wrong sizes %d, %d, %d", opts.PublicKeyBytes(), opts.SeedBytes(), opts.MessageSignatureBytes())
+	}
+
+	priv, pub, err := GenerateKey(rand.Reader, opts)
+	noerr(t, err)
+
+	msg := make([]byte, 64)
+	_, err = rand.Read(msg)
+	noerr(t, err)
+
+	sig, err := priv.Sign(msg)
+	noerr(t, err)
+	if len(sig.Bytes()) != opts.SignatureBytes() {
+		t.Errorf("wrong signature length %d", len(sig.Bytes()))
+	}
+
+	encoded, err := pub.MarshalBinary()
+	noerr(t, err)
+	var decoded PublicKey
+	noerr(t, decoded.UnmarshalBinary(encoded))
+
+	if !decoded.Verify(msg, sig) {
+		t.Error("Signature not accepted by decoded public key")
+	}
+
+	if decoded.Opts().N != 64 {
+		t.Errorf("decoded n = %d, expected 64", decoded.Opts().N)
+	}
+
+	_, err = GenPublicKeyChecked(priv.seed, priv.pubSeed, Opts{N: 64})
+	if !errors.Is(err, ErrInvalidN) {
+		t.Errorf("expected error [%v], got [%v]", ErrInvalidN, err)
+	}
+}
+
+func TestModeForW(t *testing.T) {
+	for w := 2; w <= 256; w *= 2 {
+		mode, err := ModeForW(w)
+		noerr(t, err)
+
+		if mode.String() != fmt.Sprintf("W%d", w) {
+			t.Errorf("wrong mode %s for w = %d", mode, w)
+		}
+	}
+
+	if _, err := ModeForW(12); !errors.Is(err, ErrInvalidMode) {
+		t.Errorf("expected error [%v], got [%v]", ErrInvalidMode, err)
+	}
+}
+
 func BenchmarkWOTSP(b *testing.B) {
 	for _, mode := range []Mode{W4, W16, W256} {
 		runBenches(b, mode)
@@ -0,0 +1,78 @@
+package wotsp
+
+import (
+	"fmt"
+	"hash"
+
+	"github.com/lentus/wotsp/primitives"
+)
+
+// XOF identifies an extendable-output function that is used as the internal
+// hash function instead of Opts.Hash, with an output of n bytes. The zero XOF
+// selects no extendable-output function, in which case Opts.Hash is used.
+//
+// Like the hash functions of package crypto, the XOFs are only linked into
+// the binary on request, by importing package github.com/lentus/wotsp/shake.
+type XOF int
+
+const (
+	// SHAKE128 selects SHAKE128, as used by the WOTSP-SHAKE_256 parameter set
+	// of RFC 8391.
+	SHAKE128 XOF = iota + 1
+
+	// SHAKE256 selects SHAKE256, as used by the SHAKE256 parameter sets of
+	// NIST SP 800-208.
+	SHAKE256
+)
+
+// minXOFN is the smallest security parameter n supported with an XOF, which
+// leaves room for the 4-byte domain separating prefixes of NIST SP 800-208.
+const minXOFN = 4
+
+// validate returns an error if the XOF is not valid, or if it does not support
+// the security parameter n. The zero XOF is valid for any n.
+func (x XOF) validate(n int) error {
+	switch x {
+	case 0:
+		return nil
+	case SHAKE128, SHAKE256:
+	default:
+		return fmt.Errorf("%w: invalid XOF %s, must be either wotsp.SHAKE128 or wotsp.SHAKE256", ErrUnsupportedHash, x)
+	}
+
+	if !primitives.XOF(x).Available() {
+		return fmt.Errorf("%w: %s is not linked into the binary, import github.com/lentus/wotsp/shake", ErrUnsupportedHash, x)
+	}
+
+	// The public seed must fit in a single block of the XOF, as required by
+	// the Simple tweakable hash function
+	if max := x.newFunc(n)().BlockSize(); n < minXOFN || n > max {
+		return fmt.Errorf("%w: got %d, must be between %d and %d for %s", ErrInvalidN, n, minXOFN, max, x)
+	}
+
+	return nil
+}
+
+// newFunc returns a constructor for the XOF with an output of n bytes. It
+// panics if the XOF is not valid or not available, or if it is the zero XOF.
+func (x XOF) newFunc(n int) func() hash.Hash {
+	if x != SHAKE128 && x != SHAKE256 {
+		panic(fmt.Sprintf("unsupported value for Opts.XOF [%d]", int(x)))
+	}
+
+	return func() hash.Hash { return primitives.XOF(x).New(n) }
+}
+
+// String implements fmt.Stringer.
+func (x XOF) String() string {
+	switch x {
+	case 0:
+		return "<none>"
+	case SHAKE128:
+		return "SHAKE128"
+	case SHAKE256:
+		return "SHAKE256"
+	default:
+		return fmt.Sprintf("<invalid XOF %d>", int(x))
+	}
+}
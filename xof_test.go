@@ -0,0 +1,124 @@
+package wotsp
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha3"
+	"errors"
+	"testing"
+
+	_ "github.com/lentus/wotsp/shake"
+	"github.com/lentus/wotsp/testdata"
+)
+
+// prfRecorder is a Tracer that records the output of the first PRF
+// evaluation.
+type prfRecorder struct {
+	recorder
+	adrs Address
+	out  []byte
+}
+
+func (r *prfRecorder) PRF(adrs Address, out []byte) {
+	if r.out == nil {
+		r.adrs = adrs
+		r.out = append([]byte{}, out...)
+	}
+}
+
+// TestXOF verifies that the SHAKE parameter sets compute PRF as specified, and
+// that signatures created with them verify.
+func TestXOF(t *testing.T) {
+	cases := []struct {
+		xof XOF
+		sum func([]byte, int) []byte
+	}{
+		{SHAKE128, sha3.SumSHAKE128},
+		{SHAKE256, sha3.SumSHAKE256},
+	}
+
+	for _, c := range cases {
+		rec := new(prfRecorder)
+
+		var opts Opts
+		opts.XOF = c.xof
+		opts.Tracer = rec
+
+		pubKey := GenPublicKey(testdata.Seed, testdata.PubSeed, opts)
+
+		input := append(make([]byte, N-1), 3)
+		input = append(input, testdata.PubSeed...)
+		input = append(input, rec.adrs[:]...)
+		if !bytes.Equal(rec.out, c.sum(input, N)) {
+			t.Errorf("%s: wrong PRF output", c.xof)
+		}
+
+		opts.Tracer = nil
+		sig, err := SignMessage(nil, []byte("message"), testdata.Seed, testdata.PubSeed, opts)
+		noerr(t, err)
+		if !VerifyMessage(pubKey, sig, []byte("message"), testdata.PubSeed, opts) {
+			t.Errorf("%s: valid signature rejected", c.xof)
+		}
+	}
+
+	p, err := ParamSetByOID(OIDSHAKE_256)
+	noerr(t, err)
+	if p.XOF != SHAKE128 || p.N != 32 {
+		t.Errorf("wrong parameter set %+v", p)
+	}
+}
+
+// TestXOFInvalid verifies that invalid XOF options are rejected, and that the
+// XOF is encoded with keys.
+func TestXOFInvalid(t *testing.T) {
+	for _, opts := range []Opts{{XOF: XOF(7)}, {XOF: SHAKE128, Hash: crypto.SHA256}} {
+		_, err := GenPublicKeyChecked(testdata.Seed, testdata.PubSeed, opts)
+		if !errors.Is(err, ErrUnsupportedHash) {
+			t.Errorf("expected error [%v], got [%v]", ErrUnsupportedHash, err)
+		}
+	}
+
+	pub, err := NewPublicKey(testdata.PubKey, testdata.PubSeed, Opts{XOF: SHAKE256})
+	noerr(t, err)
+
+	data, err := pub.MarshalBinary()
+	noerr(t, err)
+
+	decoded := new(PublicKey)
+	noerr(t, decoded.UnmarshalBinary(data))
+	if decoded.Opts().XOF != SHAKE256 {
+		t.Error("XOF not encoded")
+	}
+	if decoded.Equal(&PublicKey{pk: testdata.PubKey, pubSeed: testdata.PubSeed}) {
+		t.Error("Keys with different hash functions are equal")
+	}
+}
+
+// TestXOFInvalidN verifies that the security parameter n is limited for XOFs,
+// so that encodings with an unsupported n are rejected instead of causing a
+// panic.
+func TestXOFInvalidN(t *testing.T) {
+	for _, n := range []int{1, 137, 200} {
+		opts := Opts{XOF: SHAKE128, N: n}
+		if n == 137 {
+			opts.XOF = SHAKE256
+		}
+		params := W16.params(n)
+
+		data := []byte{byte(W16), byte(Robust), 0, byte(n), byte(SeedCounter), byte(opts.XOF)}
+		data = append(data, make([]byte, 32+n+params.L*n)...)
+
+		err := new(PublicKey).UnmarshalBinary(data)
+		if !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("n = %d: expected error [%v], got [%v]", n, ErrInvalidEncoding, err)
+		}
+
+		pk, sig, msg := make([]byte, params.L*n), make([]byte, params.L*n), make([]byte, n)
+		if _, err := VerifyChecked(pk, sig, msg, make([]byte, n), opts); !errors.Is(err, ErrInvalidN) {
+			t.Errorf("n = %d: expected error [%v], got [%v]", n, ErrInvalidN, err)
+		}
+		if VerifyMessage(pk, append(make([]byte, n), sig...), msg, make([]byte, n), opts) {
+			t.Errorf("n = %d: signature accepted", n)
+		}
+	}
+}